@@ -0,0 +1,126 @@
+package connectbackend_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/protoc-gen-graphql/e2e/connectbackend"
+	"github.com/tmc/protoc-gen-graphql/e2e/connectbackend/connectbackendconnect"
+	"github.com/tmc/protoc-gen-graphql/e2e/connectbackend/gengraphql"
+)
+
+func TestGetBook(t *testing.T) {
+	s := &library{books: map[string]*connectbackend.Book{
+		"1": {Id: "1", Title: "one"},
+	}}
+	h := gengraphql.Handler(client(t, s))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  getBook(req: {id: \"1\"}) {\n    title\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"getBook":{"title":"one"}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to call the connect server")
+}
+
+func TestGetBookError(t *testing.T) {
+	h := gengraphql.Handler(client(t, &library{}))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  getBook(req: {id: \"1\"}) {\n    title\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"errors":[{"message":"no book 1","path":["getBook"],"extensions":{"code":"not_found","retryable":false}}],"data":null}`
+	require.Equal(t, expected, w.Body.String(), "Expected the connect code in the error extensions")
+}
+
+func TestLoaders(t *testing.T) {
+	s := &library{books: map[string]*connectbackend.Book{
+		"1": {Id: "1", Title: "one", AuthorId: "ann"},
+	}}
+	h := gengraphql.Handler(client(t, s))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  a: getBook(req: {id: \"1\"}) {\n    author {\n      name\n    }\n  }\n  b: getBook(req: {id: \"1\"}) {\n    author {\n      name\n    }\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"a":{"author":{"name":"ANN"}},"b":{"author":{"name":"ANN"}}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to resolve the authors")
+	require.Len(t, s.batches, 1, "Expected a single batch for all authors")
+	require.Equal(t, []string{"ann"}, s.batches[0])
+}
+
+func TestHeaders(t *testing.T) {
+	s := &library{}
+	h := gengraphql.Handler(client(t, s))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  whoami {\n    user\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-User", "ann")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"whoami":{"user":"ann"}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected the headers to be forwarded to the connect server")
+	require.Equal(t, "application/proto", s.header.Get("Content-Type"))
+}
+
+// client serves s over HTTP and returns a connect client to it.
+func client(t *testing.T, s connectbackendconnect.LibraryHandler) connectbackendconnect.LibraryClient {
+	mux := http.NewServeMux()
+	mux.Handle(connectbackendconnect.NewLibraryHandler(s))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return connectbackendconnect.NewLibraryClient(srv.Client(), srv.URL)
+}
+
+type library struct {
+	connectbackendconnect.UnimplementedLibraryHandler
+
+	mu      sync.Mutex
+	books   map[string]*connectbackend.Book
+	batches [][]string
+	header  http.Header
+}
+
+func (l *library) GetBook(ctx context.Context, req *connect.Request[connectbackend.GetBookReq]) (*connect.Response[connectbackend.Book], error) {
+	book, ok := l.books[req.Msg.GetId()]
+	if !ok {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no book %v", req.Msg.GetId()))
+	}
+	return connect.NewResponse(book), nil
+}
+
+func (l *library) BatchGetAuthors(ctx context.Context, req *connect.Request[connectbackend.BatchGetAuthorsReq]) (*connect.Response[connectbackend.BatchGetAuthorsResp], error) {
+	l.mu.Lock()
+	l.batches = append(l.batches, req.Msg.GetIds())
+	l.mu.Unlock()
+	resp := &connectbackend.BatchGetAuthorsResp{}
+	for _, id := range req.Msg.GetIds() {
+		resp.Authors = append(resp.Authors, &connectbackend.Author{Id: id, Name: strings.ToUpper(id)})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (l *library) Whoami(ctx context.Context, req *connect.Request[connectbackend.WhoamiReq]) (*connect.Response[connectbackend.WhoamiResp], error) {
+	l.header = req.Header()
+	return connect.NewResponse(&connectbackend.WhoamiResp{User: req.Header().Get("X-User")}), nil
+}
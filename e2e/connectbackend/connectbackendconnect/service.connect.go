@@ -0,0 +1,170 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: service.proto
+
+package connectbackendconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	connectbackend "github.com/tmc/protoc-gen-graphql/e2e/connectbackend"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LibraryName is the fully-qualified name of the Library service.
+	LibraryName = "connectbackend.Library"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LibraryGetBookProcedure is the fully-qualified name of the Library's GetBook RPC.
+	LibraryGetBookProcedure = "/connectbackend.Library/GetBook"
+	// LibraryBatchGetAuthorsProcedure is the fully-qualified name of the Library's BatchGetAuthors RPC.
+	LibraryBatchGetAuthorsProcedure = "/connectbackend.Library/BatchGetAuthors"
+	// LibraryWhoamiProcedure is the fully-qualified name of the Library's Whoami RPC.
+	LibraryWhoamiProcedure = "/connectbackend.Library/Whoami"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	libraryServiceDescriptor               = connectbackend.File_service_proto.Services().ByName("Library")
+	libraryGetBookMethodDescriptor         = libraryServiceDescriptor.Methods().ByName("GetBook")
+	libraryBatchGetAuthorsMethodDescriptor = libraryServiceDescriptor.Methods().ByName("BatchGetAuthors")
+	libraryWhoamiMethodDescriptor          = libraryServiceDescriptor.Methods().ByName("Whoami")
+)
+
+// LibraryClient is a client for the connectbackend.Library service.
+type LibraryClient interface {
+	GetBook(context.Context, *connect.Request[connectbackend.GetBookReq]) (*connect.Response[connectbackend.Book], error)
+	BatchGetAuthors(context.Context, *connect.Request[connectbackend.BatchGetAuthorsReq]) (*connect.Response[connectbackend.BatchGetAuthorsResp], error)
+	Whoami(context.Context, *connect.Request[connectbackend.WhoamiReq]) (*connect.Response[connectbackend.WhoamiResp], error)
+}
+
+// NewLibraryClient constructs a client for the connectbackend.Library service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLibraryClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LibraryClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &libraryClient{
+		getBook: connect.NewClient[connectbackend.GetBookReq, connectbackend.Book](
+			httpClient,
+			baseURL+LibraryGetBookProcedure,
+			connect.WithSchema(libraryGetBookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchGetAuthors: connect.NewClient[connectbackend.BatchGetAuthorsReq, connectbackend.BatchGetAuthorsResp](
+			httpClient,
+			baseURL+LibraryBatchGetAuthorsProcedure,
+			connect.WithSchema(libraryBatchGetAuthorsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		whoami: connect.NewClient[connectbackend.WhoamiReq, connectbackend.WhoamiResp](
+			httpClient,
+			baseURL+LibraryWhoamiProcedure,
+			connect.WithSchema(libraryWhoamiMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// libraryClient implements LibraryClient.
+type libraryClient struct {
+	getBook         *connect.Client[connectbackend.GetBookReq, connectbackend.Book]
+	batchGetAuthors *connect.Client[connectbackend.BatchGetAuthorsReq, connectbackend.BatchGetAuthorsResp]
+	whoami          *connect.Client[connectbackend.WhoamiReq, connectbackend.WhoamiResp]
+}
+
+// GetBook calls connectbackend.Library.GetBook.
+func (c *libraryClient) GetBook(ctx context.Context, req *connect.Request[connectbackend.GetBookReq]) (*connect.Response[connectbackend.Book], error) {
+	return c.getBook.CallUnary(ctx, req)
+}
+
+// BatchGetAuthors calls connectbackend.Library.BatchGetAuthors.
+func (c *libraryClient) BatchGetAuthors(ctx context.Context, req *connect.Request[connectbackend.BatchGetAuthorsReq]) (*connect.Response[connectbackend.BatchGetAuthorsResp], error) {
+	return c.batchGetAuthors.CallUnary(ctx, req)
+}
+
+// Whoami calls connectbackend.Library.Whoami.
+func (c *libraryClient) Whoami(ctx context.Context, req *connect.Request[connectbackend.WhoamiReq]) (*connect.Response[connectbackend.WhoamiResp], error) {
+	return c.whoami.CallUnary(ctx, req)
+}
+
+// LibraryHandler is an implementation of the connectbackend.Library service.
+type LibraryHandler interface {
+	GetBook(context.Context, *connect.Request[connectbackend.GetBookReq]) (*connect.Response[connectbackend.Book], error)
+	BatchGetAuthors(context.Context, *connect.Request[connectbackend.BatchGetAuthorsReq]) (*connect.Response[connectbackend.BatchGetAuthorsResp], error)
+	Whoami(context.Context, *connect.Request[connectbackend.WhoamiReq]) (*connect.Response[connectbackend.WhoamiResp], error)
+}
+
+// NewLibraryHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLibraryHandler(svc LibraryHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	libraryGetBookHandler := connect.NewUnaryHandler(
+		LibraryGetBookProcedure,
+		svc.GetBook,
+		connect.WithSchema(libraryGetBookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryBatchGetAuthorsHandler := connect.NewUnaryHandler(
+		LibraryBatchGetAuthorsProcedure,
+		svc.BatchGetAuthors,
+		connect.WithSchema(libraryBatchGetAuthorsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	libraryWhoamiHandler := connect.NewUnaryHandler(
+		LibraryWhoamiProcedure,
+		svc.Whoami,
+		connect.WithSchema(libraryWhoamiMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/connectbackend.Library/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LibraryGetBookProcedure:
+			libraryGetBookHandler.ServeHTTP(w, r)
+		case LibraryBatchGetAuthorsProcedure:
+			libraryBatchGetAuthorsHandler.ServeHTTP(w, r)
+		case LibraryWhoamiProcedure:
+			libraryWhoamiHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLibraryHandler returns CodeUnimplemented from all methods.
+type UnimplementedLibraryHandler struct{}
+
+func (UnimplementedLibraryHandler) GetBook(context.Context, *connect.Request[connectbackend.GetBookReq]) (*connect.Response[connectbackend.Book], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connectbackend.Library.GetBook is not implemented"))
+}
+
+func (UnimplementedLibraryHandler) BatchGetAuthors(context.Context, *connect.Request[connectbackend.BatchGetAuthorsReq]) (*connect.Response[connectbackend.BatchGetAuthorsResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connectbackend.Library.BatchGetAuthors is not implemented"))
+}
+
+func (UnimplementedLibraryHandler) Whoami(context.Context, *connect.Request[connectbackend.WhoamiReq]) (*connect.Response[connectbackend.WhoamiResp], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("connectbackend.Library.Whoami is not implemented"))
+}
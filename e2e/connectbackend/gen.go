@@ -0,0 +1,4 @@
+package connectbackend
+
+//go:generate protoc -I . -I /usr/local/include -I ../.. --go_out=paths=source_relative:. --connect-go_out=paths=source_relative:. service.proto
+//go:generate protoc -I . -I /usr/local/include -I ../.. --graphql_out=backend=connect:. service.proto
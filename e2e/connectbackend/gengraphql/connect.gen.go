@@ -0,0 +1,109 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package gengraphql
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/tmc/protoc-gen-graphql/e2e/connectbackend"
+	"github.com/tmc/protoc-gen-graphql/e2e/connectbackend/connectbackendconnect"
+)
+
+// connectService is the Library service that the resolvers call.
+type connectService interface {
+	GetBook(ctx context.Context, req *connectbackend.GetBookReq) (*connectbackend.Book, error)
+	BatchGetAuthors(ctx context.Context, req *connectbackend.BatchGetAuthorsReq) (*connectbackend.BatchGetAuthorsResp, error)
+	Whoami(ctx context.Context, req *connectbackend.WhoamiReq) (*connectbackend.WhoamiResp, error)
+}
+
+// connectClient calls the RPCs of a connect client with the messages
+// of the resolvers, forwarding the headers of the GraphQL request.
+type connectClient struct {
+	client connectbackendconnect.LibraryClient
+}
+
+func newConnectService(client connectbackendconnect.LibraryClient) connectService {
+	return connectClient{client}
+}
+
+func (c connectClient) GetBook(ctx context.Context, req *connectbackend.GetBookReq) (*connectbackend.Book, error) {
+	if req == nil {
+		req = &connectbackend.GetBookReq{}
+	}
+	creq := connect.NewRequest(req)
+	forwardHeaders(ctx, creq.Header())
+	resp, err := c.client.GetBook(ctx, creq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+func (c connectClient) BatchGetAuthors(ctx context.Context, req *connectbackend.BatchGetAuthorsReq) (*connectbackend.BatchGetAuthorsResp, error) {
+	if req == nil {
+		req = &connectbackend.BatchGetAuthorsReq{}
+	}
+	creq := connect.NewRequest(req)
+	forwardHeaders(ctx, creq.Header())
+	resp, err := c.client.BatchGetAuthors(ctx, creq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+func (c connectClient) Whoami(ctx context.Context, req *connectbackend.WhoamiReq) (*connectbackend.WhoamiResp, error) {
+	if req == nil {
+		req = &connectbackend.WhoamiReq{}
+	}
+	creq := connect.NewRequest(req)
+	forwardHeaders(ctx, creq.Header())
+	resp, err := c.client.Whoami(ctx, creq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+type headersKey struct{}
+
+// withHeaders returns a context that carries the
+// headers of the GraphQL request to the service.
+func withHeaders(ctx context.Context, h http.Header) context.Context {
+	return context.WithValue(ctx, headersKey{}, h)
+}
+
+// skippedHeaders describe the HTTP request itself, rather
+// than headers that the service should receive.
+var skippedHeaders = map[string]bool{
+	"Accept-Encoding":   true,
+	"Connection":        true,
+	"Content-Length":    true,
+	"Content-Type":      true,
+	"Host":              true,
+	"Keep-Alive":        true,
+	"Proxy-Connection":  true,
+	"Te":                true,
+	"Trailer":           true,
+	"Transfer-Encoding": true,
+	"Upgrade":           true,
+	"User-Agent":        true,
+}
+
+// forwardHeaders adds the headers of the GraphQL request to h.
+func forwardHeaders(ctx context.Context, h http.Header) {
+	src, _ := ctx.Value(headersKey{}).(http.Header)
+	for k, v := range src {
+		k = http.CanonicalHeaderKey(k)
+		if skippedHeaders[k] || strings.HasPrefix(k, "Connect-") || strings.HasPrefix(k, "Grpc-") {
+			continue
+		}
+		for _, val := range v {
+			h.Add(k, val)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package gengraphql
+
+import (
+	"context"
+
+	"github.com/tmc/protoc-gen-graphql/e2e/connectbackend"
+)
+
+type Resolver struct {
+	connectService
+}
+
+func (r *Resolver) Book() BookResolver {
+	return &bookResolver{r}
+}
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{r}
+}
+
+type bookResolver struct{ *Resolver }
+
+func (r *bookResolver) Author(ctx context.Context, obj *connectbackend.Book) (*connectbackend.Author, error) {
+	return loadersFrom(ctx, r.connectService).BatchGetAuthors.Load(ctx, obj.AuthorId)
+}
+
+type queryResolver struct{ *Resolver }
+
+func (r *queryResolver) GetBook(ctx context.Context, req *connectbackend.GetBookReq) (*connectbackend.Book, error) {
+	return r.connectService.GetBook(ctx, req)
+}
+
+func (r *queryResolver) BatchGetAuthors(ctx context.Context, req *connectbackend.BatchGetAuthorsReq) (*connectbackend.BatchGetAuthorsResp, error) {
+	return r.connectService.BatchGetAuthors(ctx, req)
+}
+
+func (r *queryResolver) Whoami(ctx context.Context) (*connectbackend.WhoamiResp, error) {
+	return r.connectService.Whoami(ctx, nil)
+}
@@ -0,0 +1,8 @@
+package custom
+
+// bookResolver resolves the fields of Book.
+// The methods that you declare on it override those of
+// generatedBookResolver, which call the service.
+type bookResolver struct {
+	generatedBookResolver
+}
@@ -0,0 +1,8 @@
+package custom
+
+// breadRespResolver resolves the fields of BreadResp. Methods
+// that you declare on it override the ones of generatedBreadRespResolver,
+// which call the service.
+type breadRespResolver struct {
+	generatedBreadRespResolver
+}
@@ -0,0 +1,8 @@
+package custom
+
+// changeMeRespResolver resolves the fields of ChangeMeResp. Methods
+// that you declare on it override the ones of generatedChangeMeRespResolver,
+// which call the service.
+type changeMeRespResolver struct {
+	generatedChangeMeRespResolver
+}
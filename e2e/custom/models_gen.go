@@ -0,0 +1,28 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package custom
+
+import (
+	"github.com/tmc/protoc-gen-graphql/e2e"
+)
+
+// BookConnection is a Relay connection of Book.
+type BookConnection struct {
+	Edges    []*BookEdge `json:"edges"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
+// BookEdge is an edge in a connection of Book.
+type BookEdge struct {
+	// cursor resumes the connection right after this edge.
+	Cursor string    `json:"cursor"`
+	Node   *e2e.Book `json:"node"`
+}
+
+// PageInfo describes the page of a Relay connection.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
@@ -0,0 +1,8 @@
+package custom
+
+// mutationResolver resolves the fields of Mutation. Methods
+// that you declare on it override the ones of generatedMutationResolver,
+// which call the service.
+type mutationResolver struct {
+	generatedMutationResolver
+}
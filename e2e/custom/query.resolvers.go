@@ -0,0 +1,29 @@
+package custom
+
+import (
+	"context"
+
+	"github.com/tmc/protoc-gen-graphql/e2e"
+)
+
+// queryResolver resolves the fields of Query.
+// The methods that you declare on it override those of
+// generatedQueryResolver, which call the service.
+type queryResolver struct {
+	generatedQueryResolver
+}
+
+// Hello overrides the generated resolver
+// to prefix the text with the greeting.
+func (r *queryResolver) Hello(ctx context.Context, req *e2e.HelloReq) (*e2e.HelloResp, error) {
+	resp, err := r.Service.Hello(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &e2e.HelloResp{Text: r.Greeting + resp.GetText()}, nil
+}
+
+// Version resolves the field that custom.graphql adds to Query.
+func (r *queryResolver) Version(ctx context.Context) (string, error) {
+	return "v1", nil
+}
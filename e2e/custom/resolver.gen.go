@@ -0,0 +1,188 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package custom
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tmc/protoc-gen-graphql/e2e"
+	"github.com/tmc/protoc-gen-graphql/e2e/painters"
+)
+
+func (r *Resolver) Book() BookResolver {
+	return &bookResolver{generatedBookResolver{r}}
+}
+func (r *Resolver) BreadResp() BreadRespResolver {
+	return &breadRespResolver{generatedBreadRespResolver{r}}
+}
+func (r *Resolver) ChangeMeResp() ChangeMeRespResolver {
+	return &changeMeRespResolver{generatedChangeMeRespResolver{r}}
+}
+func (r *Resolver) Mutation() MutationResolver {
+	return &mutationResolver{generatedMutationResolver{r}}
+}
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{generatedQueryResolver{r}}
+}
+func (r *Resolver) TranslateResp() TranslateRespResolver {
+	return &translateRespResolver{generatedTranslateRespResolver{r}}
+}
+
+// generatedBookResolver resolves the fields of Book with the
+// service. The methods of bookResolver override its own.
+type generatedBookResolver struct{ *Resolver }
+
+func (r *generatedBookResolver) Author(ctx context.Context, obj *e2e.Book) (*e2e.Author, error) {
+	req := &e2e.GetAuthorReq{}
+	req.Id = obj.AuthorId
+	return r.Service.GetAuthor(ctx, req)
+}
+
+func (r *generatedBookResolver) Editor(ctx context.Context, obj *e2e.Book) (*e2e.Author, error) {
+	return loadersFrom(ctx, r.Service).BatchGetAuthors.Load(ctx, obj.EditorId)
+}
+
+// generatedBreadRespResolver resolves the fields of BreadResp with the
+// service. The methods of breadRespResolver override its own.
+type generatedBreadRespResolver struct{ *Resolver }
+
+func (r *generatedBreadRespResolver) Answer(ctx context.Context, obj *e2e.BreadResp) (unionMask, error) {
+	return obj.GetAnswer(), nil
+}
+
+// generatedChangeMeRespResolver resolves the fields of ChangeMeResp with the
+// service. The methods of changeMeRespResolver override its own.
+type generatedChangeMeRespResolver struct{ *Resolver }
+
+func (r *generatedChangeMeRespResolver) Previous(ctx context.Context, obj *e2e.ChangeMeResp) (Previous, error) {
+	return obj.GetPrevious(), nil
+}
+
+func (r *generatedChangeMeRespResolver) Answer(ctx context.Context, obj *e2e.ChangeMeResp) (unionMask, error) {
+	return obj.GetAnswer(), nil
+}
+
+// generatedMutationResolver resolves the fields of Mutation with the
+// service. The methods of mutationResolver override its own.
+type generatedMutationResolver struct{ *Resolver }
+
+func (r *generatedMutationResolver) ChangeMe(ctx context.Context, req *e2e.ChangeMeReq) (*e2e.ChangeMeResp, error) {
+	return r.Service.ChangeMe(ctx, req)
+}
+
+func (r *generatedMutationResolver) Paint(ctx context.Context, req *e2e.PaintReq) (unionMask, error) {
+	resp, err := r.Service.Paint(ctx, req)
+	for _, msg := range dataErrors(err) {
+		switch msg := msg.(type) {
+		case *e2e.OutOfPaint, *painters.NotAPainter:
+			return msg, nil
+		}
+	}
+	return resp, err
+}
+
+// generatedQueryResolver resolves the fields of Query with the
+// service. The methods of queryResolver override its own.
+type generatedQueryResolver struct{ *Resolver }
+
+func (r *generatedQueryResolver) Hello(ctx context.Context, req *e2e.HelloReq) (*e2e.HelloResp, error) {
+	return r.Service.Hello(ctx, req)
+}
+
+func (r *generatedQueryResolver) TrafficJam(ctx context.Context, req *e2e.TrafficJamReq) (*e2e.TrafficJamResp, error) {
+	return r.Service.TrafficJam(ctx, req)
+}
+
+func (r *generatedQueryResolver) GetPainters(ctx context.Context) (*e2e.PaintersResp, error) {
+	return r.Service.GetPainters(ctx, nil)
+}
+
+func (r *generatedQueryResolver) Translate(ctx context.Context, req *e2e.TranslateReq) (*e2e.TranslateResp, error) {
+	return r.Service.Translate(ctx, req)
+}
+
+func (r *generatedQueryResolver) Bread(ctx context.Context, req *e2e.BreadReq) (*e2e.BreadResp, error) {
+	return r.Service.Bread(ctx, req)
+}
+
+func (r *generatedQueryResolver) ListBooks(ctx context.Context, req *e2e.ListBooksReq, first *int, after *string) (*BookConnection, error) {
+	if req == nil {
+		req = &e2e.ListBooksReq{}
+	}
+	pageToken, offset, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	req.PageToken = pageToken
+	if first != nil {
+		if *first < 0 {
+			return nil, errors.New("first must not be negative")
+		}
+		size := *first + offset
+		if size < offset || int(int32(size)) != size {
+			return nil, errors.New("first and after exceed the maximum page size")
+		}
+		req.PageSize = int32(size)
+	}
+	resp, err := r.Service.ListBooks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	nodes := resp.Books
+	cursors := pageCursors(pageToken, resp.NextPageToken, len(nodes))
+	start, end := pageBounds(offset, first, len(nodes))
+	conn := &BookConnection{
+		PageInfo: newPageInfo(
+			cursors[start:end],
+			resp.NextPageToken != "" || end < len(nodes),
+			pageToken != "" || start > 0,
+		),
+	}
+	for i := start; i < end; i++ {
+		conn.Edges = append(conn.Edges, &BookEdge{Cursor: cursors[i], Node: nodes[i]})
+	}
+	return conn, nil
+}
+
+func (r *generatedQueryResolver) GetAuthor(ctx context.Context, req *e2e.GetAuthorReq) (*e2e.Author, error) {
+	return r.Service.GetAuthor(ctx, req)
+}
+
+func (r *generatedQueryResolver) BatchGetAuthors(ctx context.Context, req *e2e.BatchGetAuthorsReq) (*e2e.BatchGetAuthorsResp, error) {
+	return r.Service.BatchGetAuthors(ctx, req)
+}
+
+func (r *generatedQueryResolver) Greet(ctx context.Context, name *string, times *int, tags []*string, light *e2e.TrafficLight, word *e2e.Word, dictionary Dictionary, req *string) (*e2e.HelloResp, error) {
+	_req := &e2e.GreetReq{}
+	if name != nil {
+		_req.Name = *name
+	}
+	if times != nil {
+		_req.Times = int32(*times)
+	}
+	for _, v := range tags {
+		var e string
+		if v != nil {
+			e = *v
+		}
+		_req.Tags = append(_req.Tags, e)
+	}
+	if light != nil {
+		_req.Light = *light
+	}
+	_req.Word = word
+	_req.Dictionary = map[string]*e2e.Word(dictionary)
+	if req != nil {
+		_req.Req = *req
+	}
+	return r.Service.Greet(ctx, _req)
+}
+
+// generatedTranslateRespResolver resolves the fields of TranslateResp with the
+// service. The methods of translateRespResolver override its own.
+type generatedTranslateRespResolver struct{ *Resolver }
+
+func (r *generatedTranslateRespResolver) Translations(ctx context.Context, obj *e2e.TranslateResp) (Translations, error) {
+	return obj.GetTranslations(), nil
+}
@@ -0,0 +1,14 @@
+package custom
+
+import (
+	"github.com/tmc/protoc-gen-graphql/e2e"
+)
+
+// Resolver is the root resolver. Add the dependencies of
+// the resolvers that you write to it, after the service.
+type Resolver struct {
+	e2e.Service
+
+	// Greeting prefixes the texts of hello.
+	Greeting string
+}
@@ -0,0 +1,8 @@
+package custom
+
+// translateRespResolver resolves the fields of TranslateResp. Methods
+// that you declare on it override the ones of generatedTranslateRespResolver,
+// which call the service.
+type translateRespResolver struct {
+	generatedTranslateRespResolver
+}
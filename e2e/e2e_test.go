@@ -1,15 +1,32 @@
 package e2e_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"net/http/httptest"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tmc/protoc-gen-graphql/e2e"
+	"github.com/tmc/protoc-gen-graphql/e2e/custom"
 	"github.com/tmc/protoc-gen-graphql/e2e/painters"
 	"github.com/tmc/protoc-gen-graphql/e2e/gengraphql"
+	"github.com/tmc/protoc-gen-graphql/e2e/gengraphql/client"
+	library "github.com/tmc/protoc-gen-graphql/e2e/graph"
+	"github.com/tmc/protoc-gen-graphql/e2e/split/resolver"
+	"github.com/twitchtv/twirp"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/protobuf/proto"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/introspection"
 )
 
 func TestHello(t *testing.T) {
@@ -70,6 +87,22 @@ func TestTrafficJam(t *testing.T) {
 	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to return valid json")
 }
 
+func TestTrafficJamUnknownEnum(t *testing.T) {
+	s := &service{trafficJamResp: &e2e.TrafficJamResp{Next: e2e.TrafficLight(42)}}
+	h := gengraphql.Handler(s, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"variables": {},
+		"query": "query q {\n  trafficJam(req: {color: RED}) {\n next }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"trafficJam":{"next":"42"}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected open enums to print the number of undeclared values")
+}
+
 func TestPainters(t *testing.T) {
 	s := &service{paintersResp: &e2e.PaintersResp{
 		BestPainter: &painters.Painter{Name: "picasso"},
@@ -143,6 +176,83 @@ func TestBread(t *testing.T) {
 	require.Equal(t, s.breadReq.GetCount(), int64(3))
 }
 
+func TestCustomPackage(t *testing.T) {
+	s := &service{
+		translateResp: &e2e.TranslateResp{
+			Translations: map[string]*e2e.Word{"english": {Word: "hello"}},
+		},
+		breadResp: &e2e.BreadResp{
+			Answer: &e2e.BreadResp_Toasted{Toasted: true},
+		},
+	}
+	h := library.Handler(s, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"variables": {
+			"req": {
+				"words": "{\"english\": {\"word\": \"hello\"}}"
+			}
+		},
+		"query": "query q($req: TranslateReq) {\n  translate(req: $req) {\n translations }\n  bread(req: {count: 3}) {\n answer {\n __typename } }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, map[string]*e2e.Word{"english": {Word: "hello"}}, s.translateReq.GetWords())
+	expected := `{"data":{"translate":{"translations":{"english":{"word":"hello"}}},"bread":{"answer":{"__typename":"BreadRespAnswerToasted"}}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected the handler generated into package library to serve maps and unions")
+}
+
+func TestSplitPackages(t *testing.T) {
+	s := &service{
+		listBooksResp: &e2e.ListBooksResp{
+			Books:         []*e2e.Book{{Title: "one"}},
+			NextPageToken: "next",
+		},
+		breadResp: &e2e.BreadResp{
+			Answer: &e2e.BreadResp_Toasted{Toasted: true},
+		},
+		getAuthorResp: &e2e.Author{Id: "ann", Name: "Ann"},
+	}
+	h := resolver.Handler(s, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"variables": {
+			"representations": [{"__typename": "Author", "id": "ann"}]
+		},
+		"query": "query q($representations: [_Any!]!) {\n  listBooks(req: {shelf: \"fiction\"}) {\n edges {\n node {\n title } }\n pageInfo {\n hasNextPage } }\n  bread(req: {count: 3}) {\n answer {\n __typename } }\n  _entities(representations: $representations) {\n ... on Author {\n name } }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"listBooks":{"edges":[{"node":{"title":"one"}}],"pageInfo":{"hasNextPage":true}},"bread":{"answer":{"__typename":"BreadRespAnswerToasted"}},"_entities":[{"name":"Ann"}]}}`
+	require.Equal(t, expected, w.Body.String(), "Expected the handler split into exec, resolver and model packages to serve connections, unions and entities")
+}
+
+func TestFollowSchemaLayout(t *testing.T) {
+	s := &service{
+		helloResp: &e2e.HelloResp{Text: "world"},
+		breadResp: &e2e.BreadResp{
+			Answer: &e2e.BreadResp_Toasted{Toasted: true},
+		},
+	}
+	es := custom.NewExecutableSchema(custom.Config{Resolvers: &custom.Resolver{Service: s, Greeting: "hello "}})
+	h := handler.NewDefaultServer(es)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  hello(req: {name: \"gengraphql\"}) {\n text }\n  bread(req: {count: 3}) {\n answer {\n __typename } }\n  version\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"hello":{"text":"hello world"},"bread":{"answer":{"__typename":"BreadRespAnswerToasted"}},"version":"v1"}}`
+	require.Equal(t, expected, w.Body.String(), "Expected the hand-written hello resolver to override the generated one, bread to fall back to the service, and version from custom.graphql to be resolved by hand")
+	require.Equal(t, "gengraphql", s.helloReq.GetName())
+}
+
 func TestMutations(t *testing.T) {
 	s := &service{changeResp: &e2e.ChangeMeResp{
 		Name: "james",
@@ -179,6 +289,492 @@ func TestMutations(t *testing.T) {
 	require.Equal(t, s.changeReq.GetPrevious()["jack"].GetName(), "jack")
 }
 
+func TestListBooks(t *testing.T) {
+	s := &service{listBooksResp: &e2e.ListBooksResp{
+		Books: []*e2e.Book{
+			{Title: "one"},
+			{Title: "two"},
+			{Title: "three"},
+		},
+		NextPageToken: "next",
+	}}
+	h := gengraphql.Handler(s, nil)
+	query := func(after string) string {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+			"operationName": "q",
+			"variables": {
+				"req": {
+					"shelf": "fiction"
+				},
+				"after": "`+after+`"
+			},
+			"query": "query q($req: ListBooksReqConnectionInput, $after: String) {\n  listBooks(req: $req, first: 2, after: $after) {\n    edges {\n      cursor\n      node {\n        title\n      }\n    }\n    pageInfo {\n      hasNextPage\n      hasPreviousPage\n      endCursor\n    }\n  }\n}\n"
+		}`))
+		req.Header.Add("Content-Type", "application/json")
+		h.ServeHTTP(w, req)
+		return w.Body.String()
+	}
+
+	expected := `{"data":{"listBooks":{"edges":[{"cursor":"MTo","node":{"title":"one"}},{"cursor":"Mjo","node":{"title":"two"}}],"pageInfo":{"hasNextPage":true,"hasPreviousPage":false,"endCursor":"Mjo"}}}}`
+	require.Equal(t, expected, query(""), "Expected GraphQL query to return the first two books")
+	require.Equal(t, "fiction", s.listBooksReq.GetShelf())
+	require.Equal(t, int32(2), s.listBooksReq.GetPageSize())
+	require.Equal(t, "", s.listBooksReq.GetPageToken())
+
+	expected = `{"data":{"listBooks":{"edges":[{"cursor":"MDpuZXh0","node":{"title":"three"}}],"pageInfo":{"hasNextPage":true,"hasPreviousPage":true,"endCursor":"MDpuZXh0"}}}}`
+	require.Equal(t, expected, query("Mjo"), "Expected GraphQL query to resume after the second book")
+	require.Equal(t, int32(4), s.listBooksReq.GetPageSize())
+	require.Equal(t, "", s.listBooksReq.GetPageToken())
+
+	query("MDpuZXh0")
+	require.Equal(t, int32(2), s.listBooksReq.GetPageSize())
+	require.Equal(t, "next", s.listBooksReq.GetPageToken())
+}
+
+func TestListBooksNegativeFirst(t *testing.T) {
+	s := &service{listBooksResp: &e2e.ListBooksResp{}}
+	h := gengraphql.Handler(s, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  listBooks(first: -1) {\n    edges {\n      cursor\n    }\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"errors":[{"message":"first must not be negative","path":["listBooks"],"extensions":{"code":"internal","retryable":false}}],"data":null}`
+	require.Equal(t, expected, w.Body.String(), "Expected a negative first to be rejected")
+	require.Nil(t, s.listBooksReq, "Expected the RPC not to be called")
+}
+
+func TestListBooksPageSizeOverflow(t *testing.T) {
+	s := &service{listBooksResp: &e2e.ListBooksResp{}}
+	h := gengraphql.Handler(s, nil)
+	w := httptest.NewRecorder()
+	// The cursor is the offset 2147483648 into the page "tok".
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  listBooks(first: 1, after: \"MjE0NzQ4MzY0ODp0b2s\") {\n    edges {\n      cursor\n    }\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"errors":[{"message":"first and after exceed the maximum page size","path":["listBooks"],"extensions":{"code":"internal","retryable":false}}],"data":null}`
+	require.Equal(t, expected, w.Body.String(), "Expected a page size overflowing int32 to be rejected")
+	require.Nil(t, s.listBooksReq, "Expected the RPC not to be called")
+}
+
+func TestGreet(t *testing.T) {
+	s := &service{helloResp: &e2e.HelloResp{Text: "hello"}}
+	h := gengraphql.Handler(s, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  greet(name: \"gengraphql\", times: 3, tags: [\"a\", \"b\"], light: GREEN, word: {word: \"hola\", language: \"es\"}, dictionary: \"{\\\"hi\\\": {\\\"word\\\": \\\"hi\\\"}}\", req: \"r\") {\n    text\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"greet":{"text":"hello"}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to return valid json")
+	require.Equal(t, "gengraphql", s.greetReq.GetName())
+	require.Equal(t, int32(3), s.greetReq.GetTimes())
+	require.Equal(t, []string{"a", "b"}, s.greetReq.GetTags())
+	require.Equal(t, e2e.TrafficLight_GREEN, s.greetReq.GetLight())
+	require.Equal(t, "hola", s.greetReq.GetWord().GetWord())
+	require.Equal(t, "hi", s.greetReq.GetDictionary()["hi"].GetWord())
+	require.Equal(t, "r", s.greetReq.GetReq())
+}
+
+func TestFieldResolver(t *testing.T) {
+	s := &service{
+		listBooksResp: &e2e.ListBooksResp{
+			Books: []*e2e.Book{{Title: "one", AuthorId: "ann"}},
+		},
+		getAuthorResp: &e2e.Author{Id: "ann", Name: "Ann"},
+	}
+	h := gengraphql.Handler(s, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  listBooks {\n    edges {\n      node {\n        title\n        author {\n          name\n        }\n      }\n    }\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"listBooks":{"edges":[{"node":{"title":"one","author":{"name":"Ann"}}}]}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to resolve the book's author")
+	require.Equal(t, "ann", s.getAuthorReq.GetId())
+}
+
+func TestLoaders(t *testing.T) {
+	s := &service{
+		listBooksResp: &e2e.ListBooksResp{
+			Books: []*e2e.Book{
+				{Title: "one", EditorId: "ann"},
+				{Title: "two", EditorId: "bob"},
+				{Title: "three", EditorId: "ann"},
+			},
+		},
+	}
+	h := gengraphql.Handler(s, nil, gengraphql.WithLoaderBatch(10, 10*time.Millisecond))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  listBooks {\n    edges {\n      node {\n        title\n        editor {\n          name\n        }\n      }\n    }\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"listBooks":{"edges":[{"node":{"title":"one","editor":{"name":"ANN"}}},{"node":{"title":"two","editor":{"name":"BOB"}}},{"node":{"title":"three","editor":{"name":"ANN"}}}]}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to resolve the editors")
+	require.Len(t, s.batchGetAuthorsReqs, 1, "Expected a single batch for all editors")
+	require.ElementsMatch(t, []string{"ann", "bob"}, s.batchGetAuthorsReqs[0].GetIds())
+}
+
+func TestLoaderMaxBatch(t *testing.T) {
+	var mu sync.Mutex
+	batches := [][]string{}
+	l := gengraphql.NewBatchGetAuthorsLoader(func(ctx context.Context, keys []string) ([]*e2e.Author, error) {
+		mu.Lock()
+		batches = append(batches, keys)
+		mu.Unlock()
+		authors := []*e2e.Author{}
+		for _, k := range keys {
+			authors = append(authors, &e2e.Author{Id: k})
+		}
+		return authors, nil
+	}, 2, time.Hour)
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"a", "b", "a", "c", "d"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			author, err := l.Load(context.Background(), id)
+			require.NoError(t, err)
+			require.Equal(t, id, author.GetId())
+		}(id)
+	}
+	wg.Wait()
+	require.Len(t, batches, 2, "Expected full batches to be sent without waiting")
+	require.ElementsMatch(t, []string{"a", "b", "c", "d"}, append(batches[0], batches[1]...))
+}
+
+func TestEntities(t *testing.T) {
+	s := &service{getAuthorResp: &e2e.Author{Id: "ann", Name: "Ann"}}
+	h := gengraphql.Handler(s, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"variables": {
+			"representations": [{"__typename": "Author", "id": "ann"}]
+		},
+		"query": "query q($representations: [_Any!]!) {\n  _entities(representations: $representations) {\n    ... on Author {\n      id\n      name\n    }\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"_entities":[{"id":"ann","name":"Ann"}]}}`
+	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to resolve the entity")
+	require.Equal(t, "ann", s.getAuthorReq.GetId(), "Expected the representation key to populate the lookup request")
+}
+
+func TestEntitiesMissing(t *testing.T) {
+	s := missingAuthors{&service{getAuthorResp: &e2e.Author{Id: "ann", Name: "Ann"}}}
+	h := gengraphql.Handler(s, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"variables": {
+			"representations": [{"__typename": "Author", "id": "bob"}, {"__typename": "Author", "id": "ann"}]
+		},
+		"query": "query q($representations: [_Any!]!) {\n  _entities(representations: $representations) {\n    ... on Author {\n      id\n      name\n    }\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"errors":[{"message":"no author bob","path":["_entities",0],"extensions":{"code":"not_found","retryable":false}}],"data":{"_entities":[null,{"id":"ann","name":"Ann"}]}}`
+	require.Equal(t, expected, w.Body.String(), "Expected the missing entity to be null without dropping the other one")
+}
+
+// missingAuthors only finds the author whose id is ann.
+type missingAuthors struct {
+	*service
+}
+
+func (s missingAuthors) GetAuthor(ctx context.Context, req *e2e.GetAuthorReq) (*e2e.Author, error) {
+	if req.GetId() != "ann" {
+		return nil, twirp.NotFoundError("no author " + req.GetId())
+	}
+	return s.service.GetAuthor(ctx, req)
+}
+
+func TestServiceSDL(t *testing.T) {
+	h := gengraphql.Handler(&service{}, nil)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  _service {\n    sdl\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	var resp struct {
+		Data struct {
+			Service struct {
+				SDL string `json:"sdl"`
+			} `json:"_service"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Contains(t, resp.Data.Service.SDL, `extend schema @link(url: "https://specs.apollo.dev/federation/v2.0"`)
+	require.Contains(t, resp.Data.Service.SDL, `type Author @key(fields: "id") {`)
+	require.Contains(t, resp.Data.Service.SDL, "extend type Query {")
+	require.Contains(t, resp.Data.Service.SDL, "extend type Mutation {")
+	require.NotContains(t, resp.Data.Service.SDL, "_entities")
+}
+
+func TestErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err      error
+		opts     []gengraphql.Option
+		expected string
+	}{
+		"twirp": {
+			err:      twirp.NewError(twirp.Unavailable, "try again").WithMeta("after", "1s"),
+			expected: `{"errors":[{"message":"try again","path":["hello"],"extensions":{"code":"unavailable","meta":{"after":"1s"},"retryable":true}}],"data":null}`,
+		},
+		"internal": {
+			err:      errors.New("db is down"),
+			expected: `{"errors":[{"message":"db is down","path":["hello"],"extensions":{"code":"internal","retryable":false}}],"data":null}`,
+		},
+		"production": {
+			err:      twirp.InternalError("db is down").WithMeta("host", "db1"),
+			opts:     []gengraphql.Option{gengraphql.WithProduction()},
+			expected: `{"errors":[{"message":"internal error","path":["hello"],"extensions":{"code":"internal","retryable":false}}],"data":null}`,
+		},
+		"production client error": {
+			err:      twirp.InvalidArgumentError("name", "is required"),
+			opts:     []gengraphql.Option{gengraphql.WithProduction()},
+			expected: `{"errors":[{"message":"name is required","path":["hello"],"extensions":{"code":"invalid_argument","meta":{"argument":"name"},"retryable":false}}],"data":null}`,
+		},
+		"mapper": {
+			err: twirp.NotFoundError("no hello"),
+			opts: []gengraphql.Option{gengraphql.WithErrorMapper(func(ctx context.Context, err error) *gqlerror.Error {
+				if terr, ok := err.(twirp.Error); ok && terr.Code() == twirp.NotFound {
+					return &gqlerror.Error{Message: "gone", Extensions: map[string]interface{}{"code": "GONE"}}
+				}
+				return nil
+			})},
+			expected: `{"errors":[{"message":"gone","path":["hello"],"extensions":{"code":"GONE"}}],"data":null}`,
+		},
+		"extended": {
+			err:      fmt.Errorf("hello: %w", extendedError{}),
+			opts:     []gengraphql.Option{gengraphql.WithProduction()},
+			expected: `{"errors":[{"message":"hello: slow down","path":["hello"],"extensions":{"code":"RATE_LIMITED"}}],"data":null}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := &service{err: tc.err}
+			h := gengraphql.Handler(s, nil, tc.opts...)
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+				"operationName": "q",
+				"query": "query q {\n  hello(req: {name: \"gengraphql\"}) {\n    text\n  }\n}\n"
+			}`))
+			req.Header.Add("Content-Type", "application/json")
+			h.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expected, w.Body.String(), "Expected the service error in the error extensions")
+		})
+	}
+}
+
+// extendedError is an error with its own extensions,
+// like those that hand-written resolvers return.
+type extendedError struct{}
+
+func (extendedError) Error() string {
+	return "slow down"
+}
+
+func (extendedError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": "RATE_LIMITED"}
+}
+
+func TestDataErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp     *e2e.PaintResp
+		err      error
+		expected string
+	}{
+		"response": {
+			resp:     &e2e.PaintResp{Painting: "sunflowers"},
+			expected: `{"data":{"paint":{"__typename":"PaintResp","painting":"sunflowers"}}}`,
+		},
+		"error type": {
+			err:      gengraphql.NewDataError(&e2e.OutOfPaint{Message: "no more yellow", Color: "yellow"}),
+			expected: `{"data":{"paint":{"__typename":"OutOfPaint","message":"no more yellow","color":"yellow"}}}`,
+		},
+		"imported error type": {
+			err:      fmt.Errorf("paint: %w", gengraphql.NewDataError(&painters.NotAPainter{Message: "not a painter", Name: "bob"})),
+			expected: `{"data":{"paint":{"__typename":"Painters_NotAPainter","message":"not a painter"}}}`,
+		},
+		"other error type": {
+			err:      gengraphql.NewDataError(&painters.Painter{Name: "bob"}),
+			expected: `{"errors":[{"message":"painters.Painter","path":["paint"],"extensions":{"code":"internal","retryable":false}}],"data":null}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := &service{paintResp: tc.resp, err: tc.err}
+			h := gengraphql.Handler(s, nil)
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+				"operationName": "m",
+				"query": "mutation m {\n  paint(req: {painter: \"bob\", color: \"yellow\"}) {\n    __typename\n    ... on PaintResp {\n      painting\n    }\n    ... on Error {\n      message\n    }\n    ... on OutOfPaint {\n      color\n    }\n  }\n}\n"
+			}`))
+			req.Header.Add("Content-Type", "application/json")
+			h.ServeHTTP(w, req)
+
+			require.Equal(t, tc.expected, w.Body.String(), "Expected the error types to be returned as data")
+		})
+	}
+}
+
+func TestClient(t *testing.T) {
+	s := &service{}
+	srv := httptest.NewServer(gengraphql.Handler(s, nil))
+	defer srv.Close()
+	c := client.New(srv.URL, srv.Client())
+	ctx := context.Background()
+
+	t.Run("maps and oneofs", func(t *testing.T) {
+		s.changeResp = &e2e.ChangeMeResp{
+			Name:     "jim",
+			Answer:   &e2e.ChangeMeResp_NewName{NewName: "jimmy"},
+			Previous: map[string]*e2e.ChangeMeResp{"james": {Name: "james"}},
+		}
+		req := &e2e.ChangeMeReq{
+			Name:     "jim",
+			Previous: map[string]*e2e.ChangeMeResp{"james": {Name: "james"}},
+		}
+		resp, err := c.ChangeMe(ctx, req)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(req, s.changeReq), "got request %v", s.changeReq)
+		require.True(t, proto.Equal(s.changeResp, resp), "got response %v", resp)
+	})
+
+	t.Run("scalars", func(t *testing.T) {
+		s.breadResp = &e2e.BreadResp{Answer: &e2e.BreadResp_Toasted{Toasted: true}}
+		resp, err := c.Bread(ctx, &e2e.BreadReq{Count: 1 << 40})
+		require.NoError(t, err)
+		require.Equal(t, int64(1<<40), s.breadReq.GetCount())
+		require.True(t, proto.Equal(s.breadResp, resp), "got response %v", resp)
+
+		s.trafficJamResp = &e2e.TrafficJamResp{Next: e2e.TrafficLight_GREEN}
+		req := &e2e.TrafficJamReq{
+			Color:         e2e.TrafficLight_YELLOW,
+			TrafficLights: []e2e.TrafficLight{e2e.TrafficLight_RED, e2e.TrafficLight_GREEN},
+		}
+		jam, err := c.TrafficJam(ctx, req)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(req, s.trafficJamReq), "got request %v", s.trafficJamReq)
+		require.Equal(t, e2e.TrafficLight_GREEN, jam.GetNext())
+	})
+
+	t.Run("flattened", func(t *testing.T) {
+		s.helloResp = &e2e.HelloResp{Text: "hello"}
+		req := &e2e.GreetReq{
+			Name:       "bob",
+			Times:      2,
+			Tags:       []string{"a", "b"},
+			Light:      e2e.TrafficLight_GREEN,
+			Word:       &e2e.Word{Word: "hi", Language: "en"},
+			Dictionary: map[string]*e2e.Word{"hi": {Word: "salut", Language: "fr"}},
+		}
+		resp, err := c.Greet(ctx, req)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(req, s.greetReq), "got request %v", s.greetReq)
+		require.Equal(t, "hello", resp.GetText())
+	})
+
+	t.Run("no input", func(t *testing.T) {
+		s.paintersResp = &e2e.PaintersResp{
+			BestPainter: &painters.Painter{Name: "picasso"},
+			AllPainters: []string{"one", "two"},
+		}
+		resp, err := c.GetPainters(ctx, nil)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(s.paintersResp, resp), "got response %v", resp)
+	})
+
+	t.Run("selection", func(t *testing.T) {
+		s.paintersResp = &e2e.PaintersResp{
+			BestPainter: &painters.Painter{Name: "picasso"},
+			AllPainters: []string{"one", "two"},
+		}
+		resp, err := c.GetPainters(ctx, nil, client.Selection("{ allPainters }"))
+		require.NoError(t, err)
+		require.True(t, proto.Equal(&e2e.PaintersResp{AllPainters: []string{"one", "two"}}, resp), "got response %v", resp)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		s.err = twirp.NotFoundError("no such name")
+		defer func() { s.err = nil }()
+		_, err := c.Hello(ctx, &e2e.HelloReq{Name: "bob"})
+		var errs client.Errors
+		require.True(t, errors.As(err, &errs), "got error %v", err)
+		require.Equal(t, "not_found", errs[0].Code())
+		require.Equal(t, "no such name", errs[0].Message)
+	})
+
+	t.Run("data errors", func(t *testing.T) {
+		s.paintResp = &e2e.PaintResp{Painting: "sunflowers"}
+		resp, err := c.Paint(ctx, &e2e.PaintReq{Painter: "vincent", Color: "yellow"})
+		require.NoError(t, err)
+		require.Equal(t, "sunflowers", resp.GetPainting())
+
+		s.err = gengraphql.NewDataError(&painters.NotAPainter{Message: "not a painter", Name: "bob"})
+		defer func() { s.err = nil }()
+		_, err = c.Paint(ctx, &e2e.PaintReq{Painter: "bob"})
+		var dataErr *client.DataError
+		require.True(t, errors.As(err, &dataErr), "got error %v", err)
+		require.True(t, proto.Equal(&painters.NotAPainter{Message: "not a painter", Name: "bob"}, dataErr.Message), "got error type %v", dataErr.Message)
+	})
+}
+
+func TestIntrospection(t *testing.T) {
+	h := gengraphql.Handler(&service{}, nil)
+	w := httptest.NewRecorder()
+	body, err := json.Marshal(map[string]string{"query": introspection.Query})
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	var resp struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	var expected map[string]interface{}
+	bts, err := ioutil.ReadFile("gengraphql/schema.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bts, &expected))
+
+	// The Handler lists the types and directives in no particular order.
+	for _, schema := range []interface{}{resp.Data["__schema"], expected["__schema"]} {
+		for _, key := range []string{"types", "directives"} {
+			list := schema.(map[string]interface{})[key].([]interface{})
+			sort.Slice(list, func(i, j int) bool {
+				return list[i].(map[string]interface{})["name"].(string) < list[j].(map[string]interface{})["name"].(string)
+			})
+		}
+	}
+	require.Equal(t, expected, resp.Data)
+}
+
 type service struct {
 	e2e.Service
 	helloReq       *e2e.HelloReq
@@ -193,7 +789,16 @@ type service struct {
 	breadResp      *e2e.BreadResp
 	changeReq      *e2e.ChangeMeReq
 	changeResp     *e2e.ChangeMeResp
+	listBooksReq   *e2e.ListBooksReq
+	listBooksResp  *e2e.ListBooksResp
+	greetReq       *e2e.GreetReq
+	getAuthorReq   *e2e.GetAuthorReq
+	paintResp      *e2e.PaintResp
+	getAuthorResp  *e2e.Author
 	err            error
+
+	mu                  sync.Mutex
+	batchGetAuthorsReqs []*e2e.BatchGetAuthorsReq
 }
 
 func (s *service) Hello(ctx context.Context, req *e2e.HelloReq) (*e2e.HelloResp, error) {
@@ -225,3 +830,33 @@ func (s *service) ChangeMe(ctx context.Context, req *e2e.ChangeMeReq) (*e2e.Chan
 	s.changeReq = req
 	return s.changeResp, s.err
 }
+
+func (s *service) ListBooks(ctx context.Context, req *e2e.ListBooksReq) (*e2e.ListBooksResp, error) {
+	s.listBooksReq = req
+	return s.listBooksResp, s.err
+}
+
+func (s *service) Greet(ctx context.Context, req *e2e.GreetReq) (*e2e.HelloResp, error) {
+	s.greetReq = req
+	return s.helloResp, s.err
+}
+
+func (s *service) GetAuthor(ctx context.Context, req *e2e.GetAuthorReq) (*e2e.Author, error) {
+	s.getAuthorReq = req
+	return s.getAuthorResp, s.err
+}
+
+func (s *service) BatchGetAuthors(ctx context.Context, req *e2e.BatchGetAuthorsReq) (*e2e.BatchGetAuthorsResp, error) {
+	s.mu.Lock()
+	s.batchGetAuthorsReqs = append(s.batchGetAuthorsReqs, req)
+	s.mu.Unlock()
+	resp := &e2e.BatchGetAuthorsResp{}
+	for _, id := range req.GetIds() {
+		resp.Authors = append(resp.Authors, &e2e.Author{Id: id, Name: strings.ToUpper(id)})
+	}
+	return resp, s.err
+}
+
+func (s *service) Paint(ctx context.Context, req *e2e.PaintReq) (*e2e.PaintResp, error) {
+	return s.paintResp, s.err
+}
@@ -1,5 +1,8 @@
 package e2e
 
 //go:generate protoc -I . -I /usr/local/include -I .. --go_out=. service.proto
-//go:generate protoc -I . -I /usr/local/include -I .. --graphql_out=. service.proto
+//go:generate protoc -I . -I /usr/local/include -I .. --graphql_out=client=true,ts_out=true,introspection=true:. service.proto
 //go:generate protoc -I . -I /usr/local/include -I .. --graphql_out=gqlgen=false,dest=withoutgqlgen:. service.proto
+//go:generate protoc -I . -I /usr/local/include -I .. --graphql_out=output_path=graph,package=library:. service.proto
+//go:generate protoc -I . -I /usr/local/include -I .. --graphql_out=output_path=split,exec_package=exec,resolver_package=resolver,model_package=model:. service.proto
+//go:generate protoc -I . -I /usr/local/include -I .. --graphql_out=output_path=custom,resolver_layout=follow-schema,extra_schema=custom.graphql:. service.proto
@@ -0,0 +1,592 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/tmc/protoc-gen-graphql/e2e"
+	"github.com/tmc/protoc-gen-graphql/e2e/painters"
+)
+
+// Client calls the queries and mutations of the Service
+// GraphQL schema with the protobuf messages of its RPCs.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// New returns a Client of the GraphQL endpoint at url that sends
+// its requests with httpClient, or http.DefaultClient when nil.
+func New(url string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{url: url, httpClient: httpClient}
+}
+
+// CallOption configures a single call of the Client.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	selection string
+}
+
+// Selection replaces the selection set of a call, which asks
+// for every field of the response message by default. Fields
+// that are left out keep their zero values. For example:
+//
+//	client.GetBook(ctx, req, Selection("{ title }"))
+func Selection(selection string) CallOption {
+	return func(o *callOptions) {
+		o.selection = selection
+	}
+}
+
+// Error is an error of a GraphQL response, whose
+// extensions hold the code of the service error.
+type Error struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Code returns the code extension of the error.
+func (e *Error) Code() string {
+	code, _ := e.Extensions["code"].(string)
+	return code
+}
+
+// Errors are the errors of a GraphQL response.
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// DataError is returned when an operation responds with one
+// of the responds_with error types of its RPC instead of the
+// response. Message holds the error type.
+type DataError struct {
+	Message proto.Message
+}
+
+func (e *DataError) Error() string {
+	if m, ok := e.Message.(interface{ GetMessage() string }); ok {
+		return m.GetMessage()
+	}
+	return string(proto.MessageName(e.Message))
+}
+
+type operation struct {
+	name      string
+	field     string
+	document  string
+	selection string
+	variables func(req proto.Message) map[string]interface{}
+	errors    map[string]func() proto.Message
+}
+
+var helloOperation = &operation{
+	name:      "Hello",
+	field:     "hello",
+	document:  "query Hello($req: HelloReq) { hello(req: $req) %s }",
+	selection: "{ text }",
+	variables: inputVariables,
+}
+
+// Hello calls the hello query.
+func (c *Client) Hello(ctx context.Context, req *e2e.HelloReq, opts ...CallOption) (*e2e.HelloResp, error) {
+	if req == nil {
+		req = &e2e.HelloReq{}
+	}
+	resp := &e2e.HelloResp{}
+	if err := c.do(ctx, helloOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var trafficJamOperation = &operation{
+	name:      "TrafficJam",
+	field:     "trafficJam",
+	document:  "query TrafficJam($req: TrafficJamReq) { trafficJam(req: $req) %s }",
+	selection: "{ next }",
+	variables: inputVariables,
+}
+
+// TrafficJam calls the trafficJam query.
+func (c *Client) TrafficJam(ctx context.Context, req *e2e.TrafficJamReq, opts ...CallOption) (*e2e.TrafficJamResp, error) {
+	if req == nil {
+		req = &e2e.TrafficJamReq{}
+	}
+	resp := &e2e.TrafficJamResp{}
+	if err := c.do(ctx, trafficJamOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var getPaintersOperation = &operation{
+	name:      "GetPainters",
+	field:     "getPainters",
+	document:  "query GetPainters { getPainters %s }",
+	selection: "{ bestPainter { name } allPainters }",
+}
+
+// GetPainters calls the getPainters query.
+func (c *Client) GetPainters(ctx context.Context, req *e2e.PaintersReq, opts ...CallOption) (*e2e.PaintersResp, error) {
+	if req == nil {
+		req = &e2e.PaintersReq{}
+	}
+	resp := &e2e.PaintersResp{}
+	if err := c.do(ctx, getPaintersOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var translateOperation = &operation{
+	name:      "Translate",
+	field:     "translate",
+	document:  "query Translate($req: TranslateReq) { translate(req: $req) %s }",
+	selection: "{ translations }",
+	variables: inputVariables,
+}
+
+// Translate calls the translate query.
+func (c *Client) Translate(ctx context.Context, req *e2e.TranslateReq, opts ...CallOption) (*e2e.TranslateResp, error) {
+	if req == nil {
+		req = &e2e.TranslateReq{}
+	}
+	resp := &e2e.TranslateResp{}
+	if err := c.do(ctx, translateOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var breadOperation = &operation{
+	name:      "Bread",
+	field:     "bread",
+	document:  "query Bread($req: BreadReq) { bread(req: $req) %s }",
+	selection: "{ answer { __typename ... on BreadRespAnswerName { name } ... on BreadRespAnswerToasted { toasted } } }",
+	variables: inputVariables,
+}
+
+// Bread calls the bread query.
+func (c *Client) Bread(ctx context.Context, req *e2e.BreadReq, opts ...CallOption) (*e2e.BreadResp, error) {
+	if req == nil {
+		req = &e2e.BreadReq{}
+	}
+	resp := &e2e.BreadResp{}
+	if err := c.do(ctx, breadOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var changeMeOperation = &operation{
+	name:      "ChangeMe",
+	field:     "changeMe",
+	document:  "mutation ChangeMe($req: ChangeMeReq) { changeMe(req: $req) %s }",
+	selection: "{ name previous answer { __typename ... on ChangeMeRespAnswerNewName { newName } ... on ChangeMeRespAnswerChanged { changed } } }",
+	variables: inputVariables,
+}
+
+// ChangeMe calls the changeMe mutation.
+func (c *Client) ChangeMe(ctx context.Context, req *e2e.ChangeMeReq, opts ...CallOption) (*e2e.ChangeMeResp, error) {
+	if req == nil {
+		req = &e2e.ChangeMeReq{}
+	}
+	resp := &e2e.ChangeMeResp{}
+	if err := c.do(ctx, changeMeOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var getAuthorOperation = &operation{
+	name:      "GetAuthor",
+	field:     "getAuthor",
+	document:  "query GetAuthor($req: GetAuthorReq) { getAuthor(req: $req) %s }",
+	selection: "{ id name }",
+	variables: inputVariables,
+}
+
+// GetAuthor calls the getAuthor query.
+func (c *Client) GetAuthor(ctx context.Context, req *e2e.GetAuthorReq, opts ...CallOption) (*e2e.Author, error) {
+	if req == nil {
+		req = &e2e.GetAuthorReq{}
+	}
+	resp := &e2e.Author{}
+	if err := c.do(ctx, getAuthorOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var batchGetAuthorsOperation = &operation{
+	name:      "BatchGetAuthors",
+	field:     "batchGetAuthors",
+	document:  "query BatchGetAuthors($req: BatchGetAuthorsReq) { batchGetAuthors(req: $req) %s }",
+	selection: "{ authors { id name } }",
+	variables: inputVariables,
+}
+
+// BatchGetAuthors calls the batchGetAuthors query.
+func (c *Client) BatchGetAuthors(ctx context.Context, req *e2e.BatchGetAuthorsReq, opts ...CallOption) (*e2e.BatchGetAuthorsResp, error) {
+	if req == nil {
+		req = &e2e.BatchGetAuthorsReq{}
+	}
+	resp := &e2e.BatchGetAuthorsResp{}
+	if err := c.do(ctx, batchGetAuthorsOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var greetOperation = &operation{
+	name:      "Greet",
+	field:     "greet",
+	document:  "query Greet($name: String, $times: Int, $tags: [String], $light: TrafficLight, $word: Word, $dictionary: Dictionary, $req: String) { greet(name: $name, times: $times, tags: $tags, light: $light, word: $word, dictionary: $dictionary, req: $req) %s }",
+	selection: "{ text }",
+	variables: flattenedVariables,
+}
+
+// Greet calls the greet query.
+func (c *Client) Greet(ctx context.Context, req *e2e.GreetReq, opts ...CallOption) (*e2e.HelloResp, error) {
+	if req == nil {
+		req = &e2e.GreetReq{}
+	}
+	resp := &e2e.HelloResp{}
+	if err := c.do(ctx, greetOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+var paintOperation = &operation{
+	name:      "Paint",
+	field:     "paint",
+	document:  "mutation Paint($req: PaintReq) { paint(req: $req) %s }",
+	selection: "{ __typename ... on PaintResp { painting } ... on OutOfPaint { message color } ... on Painters_NotAPainter { message name } }",
+	variables: inputVariables,
+	errors: map[string]func() proto.Message{
+		"OutOfPaint":           func() proto.Message { return &e2e.OutOfPaint{} },
+		"Painters_NotAPainter": func() proto.Message { return &painters.NotAPainter{} },
+	},
+}
+
+// Paint calls the paint mutation.
+func (c *Client) Paint(ctx context.Context, req *e2e.PaintReq, opts ...CallOption) (*e2e.PaintResp, error) {
+	if req == nil {
+		req = &e2e.PaintReq{}
+	}
+	resp := &e2e.PaintResp{}
+	if err := c.do(ctx, paintOperation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) do(ctx context.Context, op *operation, req, resp proto.Message, opts []CallOption) error {
+	o := &callOptions{selection: op.selection}
+	for _, opt := range opts {
+		opt(o)
+	}
+	params := map[string]interface{}{
+		"operationName": op.name,
+		"query":         fmt.Sprintf(op.document, o.selection),
+	}
+	if op.variables != nil {
+		params["variables"] = op.variables(req)
+	}
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	hresp, err := c.httpClient.Do(hreq)
+	if err != nil {
+		return err
+	}
+	defer hresp.Body.Close()
+	var result struct {
+		Data   map[string]json.RawMessage `json:"data"`
+		Errors Errors                     `json:"errors"`
+	}
+	if err := json.NewDecoder(hresp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("graphql: %v: %w", hresp.Status, err)
+	}
+	if len(result.Errors) > 0 {
+		return result.Errors
+	}
+	var data map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(result.Data[op.field]))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil || data == nil {
+		return fmt.Errorf("graphql: %v has no data", op.field)
+	}
+	typename, _ := data["__typename"].(string)
+	if newError, ok := op.errors[typename]; ok {
+		msg := newError()
+		if err := decodeMessage(msg.ProtoReflect(), data); err != nil {
+			return fmt.Errorf("graphql: %v: %w", op.field, err)
+		}
+		return &DataError{Message: msg}
+	}
+	if err := decodeMessage(resp.ProtoReflect(), data); err != nil {
+		return fmt.Errorf("graphql: %v: %w", op.field, err)
+	}
+	return nil
+}
+
+// inputVariables passes the request as the req argument.
+func inputVariables(req proto.Message) map[string]interface{} {
+	return map[string]interface{}{"req": encodeMessage(req.ProtoReflect(), false)}
+}
+
+// flattenedVariables passes each field of
+// the request as an argument of its own.
+func flattenedVariables(req proto.Message) map[string]interface{} {
+	return encodeMessage(req.ProtoReflect(), false)
+}
+
+// encodeMessage returns the input object of a message. Oneofs
+// have no input fields so they are left out. Maps are scalars
+// that hold their JSON encoding, so the values within them are
+// encoded the way encoding/json decodes them instead.
+func encodeMessage(m protoreflect.Message, inMap bool) map[string]interface{} {
+	obj := map[string]interface{}{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+			return true
+		}
+		obj[string(fd.Name())] = encodeField(fd, v, inMap)
+		return true
+	})
+	return obj
+}
+
+func encodeField(fd protoreflect.FieldDescriptor, v protoreflect.Value, inMap bool) interface{} {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		vals := make([]interface{}, list.Len())
+		for i := range vals {
+			vals[i] = encodeValue(fd, list.Get(i), inMap)
+		}
+		return vals
+	case fd.IsMap():
+		obj := map[string]interface{}{}
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			obj[k.String()] = encodeValue(fd.MapValue(), v, true)
+			return true
+		})
+		if inMap {
+			return obj
+		}
+		bts, _ := json.Marshal(obj)
+		return string(bts)
+	}
+	return encodeValue(fd, v, inMap)
+}
+
+func encodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, inMap bool) interface{} {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil && !inMap {
+			return string(ev.Name())
+		}
+		return v.Enum()
+	case protoreflect.BytesKind:
+		if inMap {
+			return v.Bytes()
+		}
+		// ProtoBytes holds the JSON encoding of the bytes.
+		bts, _ := json.Marshal(v.Bytes())
+		return string(bts)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return encodeMessage(v.Message(), inMap)
+	}
+	return v.Interface()
+}
+
+// decodeMessage sets the fields of a message from a GraphQL object.
+// A oneof holds an object of one of its union's member types, whose
+// single field is set on the message itself. Fields that the message
+// does not have, such as the ones resolved by other RPCs, are skipped.
+func decodeMessage(m protoreflect.Message, obj map[string]interface{}) error {
+	desc := m.Descriptor()
+	for name, val := range obj {
+		if val == nil {
+			continue
+		}
+		if fd := desc.Fields().ByName(protoreflect.Name(name)); fd != nil {
+			if err := decodeField(m, fd, val); err != nil {
+				return fmt.Errorf("%v: %w", name, err)
+			}
+			continue
+		}
+		if oo := desc.Oneofs().ByName(protoreflect.Name(name)); oo != nil {
+			member, ok := val.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%v: expected an object, got %T", name, val)
+			}
+			if err := decodeMessage(m, member); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func decodeField(m protoreflect.Message, fd protoreflect.FieldDescriptor, val interface{}) error {
+	switch {
+	case fd.IsList():
+		vals, ok := val.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected a list, got %T", val)
+		}
+		list := m.Mutable(fd).List()
+		for _, val := range vals {
+			if val == nil {
+				continue
+			}
+			v, err := decodeValue(fd, list.NewElement(), val)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	case fd.IsMap():
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected an object, got %T", val)
+		}
+		mp := m.Mutable(fd).Map()
+		for key, val := range obj {
+			k, err := decodeValue(fd.MapKey(), protoreflect.Value{}, key)
+			if err != nil {
+				return err
+			}
+			v, err := decodeValue(fd.MapValue(), mp.NewValue(), val)
+			if err != nil {
+				return err
+			}
+			mp.Set(k.MapKey(), v)
+		}
+		return nil
+	}
+	v, err := decodeValue(fd, m.NewField(fd), val)
+	if err != nil {
+		return err
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+// decodeValue returns the value of a single field from a GraphQL
+// value, or from a JSON value within a map. Message values are
+// decoded into v, and numbers may also be held by strings.
+func decodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, val interface{}) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return v, fmt.Errorf("expected an object, got %T", val)
+		}
+		return v, decodeMessage(v.Message(), obj)
+	case protoreflect.StringKind:
+		if s, ok := val.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.BytesKind:
+		if s, ok := val.(string); ok {
+			b, err := base64.StdEncoding.DecodeString(s)
+			return protoreflect.ValueOfBytes(b), err
+		}
+	case protoreflect.BoolKind:
+		switch val := val.(type) {
+		case bool:
+			return protoreflect.ValueOfBool(val), nil
+		case string:
+			b, err := strconv.ParseBool(val)
+			return protoreflect.ValueOfBool(b), err
+		}
+	case protoreflect.EnumKind:
+		if s, ok := val.(string); ok {
+			if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+				return protoreflect.ValueOfEnum(ev.Number()), nil
+			}
+		}
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseInt(s, 10, 32)
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err
+		}
+		return v, fmt.Errorf("unknown %v value %v", fd.Enum().FullName(), val)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if s, ok := number(val); ok {
+			f, err := strconv.ParseFloat(s, 64)
+			if fd.Kind() == protoreflect.FloatKind {
+				return protoreflect.ValueOfFloat32(float32(f)), err
+			}
+			return protoreflect.ValueOfFloat64(f), err
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseInt(s, 10, 32)
+			return protoreflect.ValueOfInt32(int32(n)), err
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseInt(s, 10, 64)
+			return protoreflect.ValueOfInt64(n), err
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseUint(s, 10, 32)
+			return protoreflect.ValueOfUint32(uint32(n)), err
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseUint(s, 10, 64)
+			return protoreflect.ValueOfUint64(n), err
+		}
+	}
+	return v, fmt.Errorf("cannot use %T as %v", val, fd.Kind())
+}
+
+func number(val interface{}) (string, bool) {
+	switch val := val.(type) {
+	case json.Number:
+		return string(val), true
+	case string:
+		return val, true
+	}
+	return "", false
+}
@@ -0,0 +1,72 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package gengraphql
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// A cursor points right after a node of a connection. Page tokens can
+// only resume at the start of a page, so a cursor is the token of the
+// page holding the node along with the number of nodes to skip from it.
+func encodeCursor(pageToken string, offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset) + ":" + pageToken))
+}
+
+func decodeCursor(after *string) (string, int, error) {
+	if after == nil || *after == "" {
+		return "", 0, nil
+	}
+	bts, err := base64.RawURLEncoding.DecodeString(*after)
+	if err != nil {
+		return "", 0, errors.New("invalid cursor")
+	}
+	parts := strings.SplitN(string(bts), ":", 2)
+	offset, err := strconv.Atoi(parts[0])
+	if err != nil || len(parts) != 2 || offset < 0 {
+		return "", 0, errors.New("invalid cursor")
+	}
+	return parts[1], offset, nil
+}
+
+// pageCursors returns the cursors of the n nodes of the page fetched
+// with pageToken. The last node's cursor points to the next page
+// so that resuming after it does not fetch the same page again.
+func pageCursors(pageToken, nextPageToken string, n int) []string {
+	cursors := make([]string, n)
+	for i := range cursors {
+		cursors[i] = encodeCursor(pageToken, i+1)
+	}
+	if n > 0 && nextPageToken != "" {
+		cursors[n-1] = encodeCursor(nextPageToken, 0)
+	}
+	return cursors
+}
+
+// pageBounds returns the range of the n nodes of a page to
+// return once the first offset nodes are skipped.
+func pageBounds(offset int, first *int, n int) (int, int) {
+	start, end := offset, n
+	if start > n {
+		start = n
+	}
+	if first != nil && *first >= 0 && start+*first < end {
+		end = start + *first
+	}
+	return start, end
+}
+
+func newPageInfo(cursors []string, hasNextPage, hasPreviousPage bool) *PageInfo {
+	info := &PageInfo{
+		HasNextPage:     hasNextPage,
+		HasPreviousPage: hasPreviousPage,
+	}
+	if len(cursors) > 0 {
+		info.StartCursor = &cursors[0]
+		info.EndCursor = &cursors[len(cursors)-1]
+	}
+	return info
+}
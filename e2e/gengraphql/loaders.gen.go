@@ -0,0 +1,146 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package gengraphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tmc/protoc-gen-graphql/e2e"
+)
+
+// Loaders holds the DataLoaders of a single GraphQL request
+// so that fields resolved through batch RPCs are fetched
+// together instead of one call per field.
+type Loaders struct {
+	BatchGetAuthors *BatchGetAuthorsLoader
+}
+
+// NewLoaders returns the DataLoaders of the service's batch RPCs.
+// A batch is sent once it holds maxBatch keys, or wait after
+// its first key was added. A maxBatch of 0 means no limit.
+func NewLoaders(service e2e.Service, maxBatch int, wait time.Duration) *Loaders {
+	return &Loaders{
+		BatchGetAuthors: NewBatchGetAuthorsLoader(func(ctx context.Context, keys []string) ([]*e2e.Author, error) {
+			resp, err := service.BatchGetAuthors(ctx, &e2e.BatchGetAuthorsReq{Ids: keys})
+			if err != nil {
+				return nil, err
+			}
+			return resp.Authors, nil
+		}, maxBatch, wait),
+	}
+}
+
+type loadersKey struct{}
+
+// WithLoaders returns a context that carries the given loaders.
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, loaders)
+}
+
+// loadersFrom returns the loaders of the request. Handler adds
+// them to every request, but an executable schema that is served
+// some other way must add them with WithLoaders: without them,
+// each field gets loaders that do not outlive its own call, so
+// that batch RPCs are called once per field instead of batched.
+func loadersFrom(ctx context.Context, service e2e.Service) *Loaders {
+	if loaders, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return loaders
+	}
+	return NewLoaders(service, 0, 0)
+}
+
+// BatchGetAuthorsLoader batches and caches the calls to BatchGetAuthors.
+type BatchGetAuthorsLoader struct {
+	fetch    func(ctx context.Context, keys []string) ([]*e2e.Author, error)
+	maxBatch int
+	wait     time.Duration
+
+	mu    sync.Mutex
+	cache map[string]*batchGetAuthorsResult
+	batch *batchGetAuthorsBatch
+}
+
+// batchGetAuthorsResult is the eventual result of a key.
+type batchGetAuthorsResult struct {
+	done chan struct{}
+	item *e2e.Author
+	err  error
+}
+
+type batchGetAuthorsBatch struct {
+	keys    []string
+	results []*batchGetAuthorsResult
+	sent    bool
+}
+
+// NewBatchGetAuthorsLoader returns a loader that passes batches of
+// keys to fetch, which must return an item for each key in
+// the same order.
+func NewBatchGetAuthorsLoader(fetch func(ctx context.Context, keys []string) ([]*e2e.Author, error), maxBatch int, wait time.Duration) *BatchGetAuthorsLoader {
+	return &BatchGetAuthorsLoader{
+		fetch:    fetch,
+		maxBatch: maxBatch,
+		wait:     wait,
+		cache:    map[string]*batchGetAuthorsResult{},
+	}
+}
+
+// Load returns the item of the given key once the batch that
+// holds it has been fetched. The batch is fetched with the
+// values of the context of one of its calls, but not with its
+// cancelation, since the other calls of the batch wait for it.
+func (l *BatchGetAuthorsLoader) Load(ctx context.Context, key string) (*e2e.Author, error) {
+	l.mu.Lock()
+	res, ok := l.cache[key]
+	if !ok {
+		res = &batchGetAuthorsResult{done: make(chan struct{})}
+		l.cache[key] = res
+		if l.batch == nil {
+			b := &batchGetAuthorsBatch{}
+			l.batch = b
+			time.AfterFunc(l.wait, func() { l.send(context.WithoutCancel(ctx), b) })
+		}
+		b := l.batch
+		b.keys = append(b.keys, key)
+		b.results = append(b.results, res)
+		if l.maxBatch > 0 && len(b.keys) >= l.maxBatch {
+			l.batch = nil
+			go l.send(context.WithoutCancel(ctx), b)
+		}
+	}
+	l.mu.Unlock()
+	select {
+	case <-res.done:
+		return res.item, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *BatchGetAuthorsLoader) send(ctx context.Context, b *batchGetAuthorsBatch) {
+	l.mu.Lock()
+	if b.sent {
+		l.mu.Unlock()
+		return
+	}
+	b.sent = true
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+	items, err := l.fetch(ctx, b.keys)
+	if err == nil && len(items) != len(b.keys) {
+		err = fmt.Errorf("BatchGetAuthors returned %d items for %d keys", len(items), len(b.keys))
+	}
+	for i, res := range b.results {
+		if err != nil {
+			res.err = err
+		} else {
+			res.item = items[i]
+		}
+		close(res.done)
+	}
+}
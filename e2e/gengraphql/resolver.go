@@ -4,14 +4,19 @@ package gengraphql
 
 import (
 	"context"
+	"errors"
 
 	"github.com/tmc/protoc-gen-graphql/e2e"
+	"github.com/tmc/protoc-gen-graphql/e2e/painters"
 )
 
 type Resolver struct {
 	e2e.Service
 }
 
+func (r *Resolver) Book() BookResolver {
+	return &bookResolver{r}
+}
 func (r *Resolver) BreadResp() BreadRespResolver {
 	return &breadRespResolver{r}
 }
@@ -28,6 +33,18 @@ func (r *Resolver) TranslateResp() TranslateRespResolver {
 	return &translateRespResolver{r}
 }
 
+type bookResolver struct{ *Resolver }
+
+func (r *bookResolver) Author(ctx context.Context, obj *e2e.Book) (*e2e.Author, error) {
+	req := &e2e.GetAuthorReq{}
+	req.Id = obj.AuthorId
+	return r.Service.GetAuthor(ctx, req)
+}
+
+func (r *bookResolver) Editor(ctx context.Context, obj *e2e.Book) (*e2e.Author, error) {
+	return loadersFrom(ctx, r.Service).BatchGetAuthors.Load(ctx, obj.EditorId)
+}
+
 type breadRespResolver struct{ *Resolver }
 
 func (r *breadRespResolver) Answer(ctx context.Context, obj *e2e.BreadResp) (unionMask, error) {
@@ -50,6 +67,17 @@ func (r *mutationResolver) ChangeMe(ctx context.Context, req *e2e.ChangeMeReq) (
 	return r.Service.ChangeMe(ctx, req)
 }
 
+func (r *mutationResolver) Paint(ctx context.Context, req *e2e.PaintReq) (unionMask, error) {
+	resp, err := r.Service.Paint(ctx, req)
+	for _, msg := range dataErrors(err) {
+		switch msg := msg.(type) {
+		case *e2e.OutOfPaint, *painters.NotAPainter:
+			return msg, nil
+		}
+	}
+	return resp, err
+}
+
 type queryResolver struct{ *Resolver }
 
 func (r *queryResolver) Hello(ctx context.Context, req *e2e.HelloReq) (*e2e.HelloResp, error) {
@@ -72,6 +100,79 @@ func (r *queryResolver) Bread(ctx context.Context, req *e2e.BreadReq) (*e2e.Brea
 	return r.Service.Bread(ctx, req)
 }
 
+func (r *queryResolver) ListBooks(ctx context.Context, req *e2e.ListBooksReq, first *int, after *string) (*BookConnection, error) {
+	if req == nil {
+		req = &e2e.ListBooksReq{}
+	}
+	pageToken, offset, err := decodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	req.PageToken = pageToken
+	if first != nil {
+		if *first < 0 {
+			return nil, errors.New("first must not be negative")
+		}
+		size := *first + offset
+		if size < offset || int(int32(size)) != size {
+			return nil, errors.New("first and after exceed the maximum page size")
+		}
+		req.PageSize = int32(size)
+	}
+	resp, err := r.Service.ListBooks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	nodes := resp.Books
+	cursors := pageCursors(pageToken, resp.NextPageToken, len(nodes))
+	start, end := pageBounds(offset, first, len(nodes))
+	conn := &BookConnection{
+		PageInfo: newPageInfo(
+			cursors[start:end],
+			resp.NextPageToken != "" || end < len(nodes),
+			pageToken != "" || start > 0,
+		),
+	}
+	for i := start; i < end; i++ {
+		conn.Edges = append(conn.Edges, &BookEdge{Cursor: cursors[i], Node: nodes[i]})
+	}
+	return conn, nil
+}
+
+func (r *queryResolver) GetAuthor(ctx context.Context, req *e2e.GetAuthorReq) (*e2e.Author, error) {
+	return r.Service.GetAuthor(ctx, req)
+}
+
+func (r *queryResolver) BatchGetAuthors(ctx context.Context, req *e2e.BatchGetAuthorsReq) (*e2e.BatchGetAuthorsResp, error) {
+	return r.Service.BatchGetAuthors(ctx, req)
+}
+
+func (r *queryResolver) Greet(ctx context.Context, name *string, times *int, tags []*string, light *e2e.TrafficLight, word *e2e.Word, dictionary Dictionary, req *string) (*e2e.HelloResp, error) {
+	_req := &e2e.GreetReq{}
+	if name != nil {
+		_req.Name = *name
+	}
+	if times != nil {
+		_req.Times = int32(*times)
+	}
+	for _, v := range tags {
+		var e string
+		if v != nil {
+			e = *v
+		}
+		_req.Tags = append(_req.Tags, e)
+	}
+	if light != nil {
+		_req.Light = *light
+	}
+	_req.Word = word
+	_req.Dictionary = map[string]*e2e.Word(dictionary)
+	if req != nil {
+		_req.Req = *req
+	}
+	return r.Service.Greet(ctx, _req)
+}
+
 type translateRespResolver struct{ *Resolver }
 
 func (r *translateRespResolver) Translations(ctx context.Context, obj *e2e.TranslateResp) (Translations, error) {
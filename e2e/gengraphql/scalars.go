@@ -9,6 +9,20 @@ import (
 	"github.com/tmc/protoc-gen-graphql/e2e"
 )
 
+type Dictionary map[string]*e2e.Word
+
+func (scalar *Dictionary) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal([]byte(str), scalar)
+}
+
+func (scalar Dictionary) MarshalGQL(w io.Writer) {
+	json.NewEncoder(w).Encode(scalar)
+}
+
 type Previous map[string]*e2e.ChangeMeResp
 
 func (scalar *Previous) UnmarshalGQL(v interface{}) error {
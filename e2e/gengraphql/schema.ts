@@ -0,0 +1,210 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+export type Dictionary = Record<string, unknown>;
+
+export type Previous = Record<string, unknown>;
+
+export type Translations = Record<string, unknown>;
+
+export type Words = Record<string, unknown>;
+
+export type FieldSet = string;
+
+export type _Any = Record<string, unknown>;
+
+export enum TrafficLight {
+  RED = "RED",
+  YELLOW = "YELLOW",
+  GREEN = "GREEN",
+}
+
+/**
+ * Error is implemented by the error types that RPCs respond with instead of failing.
+ */
+export interface Error {
+  message: string;
+}
+
+export interface Author {
+  __typename?: "Author";
+  id: string;
+  name: string;
+}
+
+export interface BatchGetAuthorsResp {
+  __typename?: "BatchGetAuthorsResp";
+  authors: Array<Author | null>;
+}
+
+export interface Book {
+  __typename?: "Book";
+  title: string;
+  author_id: string;
+  editor_id: string;
+  author?: Author | null;
+  editor?: Author | null;
+}
+
+/**
+ * BookConnection is a Relay connection of Book.
+ */
+export interface BookConnection {
+  __typename?: "BookConnection";
+  edges: Array<BookEdge | null>;
+  pageInfo: PageInfo;
+}
+
+/**
+ * BookEdge is an edge in a connection of Book.
+ */
+export interface BookEdge {
+  __typename?: "BookEdge";
+  /**
+   * cursor resumes the connection right after this edge.
+   */
+  cursor: string;
+  node: Book;
+}
+
+export interface BreadResp {
+  __typename?: "BreadResp";
+  answer: BreadRespAnswer;
+}
+
+export interface BreadRespAnswerName {
+  __typename?: "BreadRespAnswerName";
+  name: string;
+}
+
+export interface BreadRespAnswerToasted {
+  __typename?: "BreadRespAnswerToasted";
+  toasted: boolean;
+}
+
+export interface ChangeMeResp {
+  __typename?: "ChangeMeResp";
+  name: string;
+  previous: Previous;
+  answer: ChangeMeRespAnswer;
+}
+
+export interface ChangeMeRespAnswerChanged {
+  __typename?: "ChangeMeRespAnswerChanged";
+  changed: boolean;
+}
+
+export interface ChangeMeRespAnswerNewName {
+  __typename?: "ChangeMeRespAnswerNewName";
+  newName: string;
+}
+
+export interface HelloResp {
+  __typename?: "HelloResp";
+  text: string;
+}
+
+export interface OutOfPaint extends Error {
+  __typename?: "OutOfPaint";
+  message: string;
+  color: string;
+}
+
+/**
+ * PageInfo describes the page of a Relay connection.
+ */
+export interface PageInfo {
+  __typename?: "PageInfo";
+  hasNextPage: boolean;
+  hasPreviousPage: boolean;
+  startCursor?: string | null;
+  endCursor?: string | null;
+}
+
+export interface PaintResp {
+  __typename?: "PaintResp";
+  painting: string;
+}
+
+export interface PaintersResp {
+  __typename?: "PaintersResp";
+  bestPainter: Painters_Painter;
+  allPainters: Array<string | null>;
+}
+
+export interface Painters_NotAPainter extends Error {
+  __typename?: "Painters_NotAPainter";
+  message: string;
+  name: string;
+}
+
+export interface Painters_Painter {
+  __typename?: "Painters_Painter";
+  name: string;
+}
+
+export interface TrafficJamResp {
+  __typename?: "TrafficJamResp";
+  next: TrafficLight;
+}
+
+export interface TranslateResp {
+  __typename?: "TranslateResp";
+  translations: Translations;
+}
+
+export interface _Service {
+  __typename?: "_Service";
+  sdl: string;
+}
+
+export interface BatchGetAuthorsReq {
+  ids?: Array<string | null> | null;
+}
+
+export interface BreadReq {
+  count?: number | null;
+}
+
+export interface ChangeMeReq {
+  name?: string | null;
+  previous?: string | null;
+}
+
+export interface GetAuthorReq {
+  id?: string | null;
+}
+
+export interface HelloReq {
+  name?: string | null;
+}
+
+export interface ListBooksReqConnectionInput {
+  shelf?: string | null;
+}
+
+export interface PaintReq {
+  painter?: string | null;
+  color?: string | null;
+}
+
+export interface TrafficJamReq {
+  color?: TrafficLight | null;
+  trafficLights?: Array<TrafficLight | null> | null;
+}
+
+export interface TranslateReq {
+  words?: string | null;
+}
+
+export interface Word {
+  word?: string | null;
+  language?: string | null;
+}
+
+export type BreadRespAnswer = BreadRespAnswerName | BreadRespAnswerToasted;
+
+export type ChangeMeRespAnswer = ChangeMeRespAnswerNewName | ChangeMeRespAnswerChanged;
+
+export type PaintResult = PaintResp | OutOfPaint | Painters_NotAPainter;
+
+export type _Entity = Author;
@@ -0,0 +1,184 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package library
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/tmc/protoc-gen-graphql/e2e"
+	"github.com/twitchtv/twirp"
+	"github.com/twitchtv/twirp/ctxsetters"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Playground is a proxy to github.com/99designs/gqlgen/handler.Playground
+// All you need to do is provide a title and the URL Path to the GraphQL handler
+func Playground(title, endpoint string) http.Handler {
+	return playground.Handler(title, endpoint)
+}
+
+// Option configures the handler returned by Handler.
+type Option func(*options)
+
+type options struct {
+	maxBatch    int
+	wait        time.Duration
+	production  bool
+	errorMapper ErrorMapper
+}
+
+// WithLoaderBatch configures the DataLoaders of batch RPCs: a batch
+// is sent once it holds maxBatch keys, or wait after its first key.
+// It defaults to 100 keys and 1ms.
+func WithLoaderBatch(maxBatch int, wait time.Duration) Option {
+	return func(o *options) {
+		o.maxBatch = maxBatch
+		o.wait = wait
+	}
+}
+
+// WithProduction hides the message and meta of internal errors,
+// such as unknown, internal and data_loss errors, from clients.
+func WithProduction() Option {
+	return func(o *options) {
+		o.production = true
+	}
+}
+
+// ErrorMapper maps an error returned by a resolver to the error
+// of the GraphQL response. It returns nil to keep the default.
+type ErrorMapper func(ctx context.Context, err error) *gqlerror.Error
+
+// WithErrorMapper maps the errors of the resolvers with m
+// before they are mapped by the default error presenter.
+func WithErrorMapper(m ErrorMapper) Option {
+	return func(o *options) {
+		o.errorMapper = m
+	}
+}
+
+// Handler returns a handler to the GraphQL API.
+// Server Hooks are optional but if present, they will
+// be injected as GraphQL middleware.
+func Handler(service e2e.Service, hooks *twirp.ServerHooks, opts ...Option) *handler.Server {
+	o := &options{maxBatch: 100, wait: time.Millisecond}
+	for _, opt := range opts {
+		opt(o)
+	}
+	es := NewExecutableSchema(Config{Resolvers: &Resolver{Service: service}})
+	srv := handler.New(es)
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.SetErrorPresenter(errorPresenter(o))
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(WithLoaders(ctx, NewLoaders(service, o.maxBatch, o.wait)))
+	})
+	if hooks == nil {
+		return srv
+	}
+	srv.AroundFields(func(ctx context.Context, next graphql.Resolver) (res interface{}, err error) {
+		f := graphql.GetFieldContext(ctx)
+		parent := f.Parent.Path().String()
+		if parent != "" {
+			return next(ctx)
+		}
+		ctx = ctxsetters.WithMethodName(ctx, f.Field.Name)
+		if hooks.RequestRouted != nil {
+			ctx, err = hooks.RequestRouted(ctx)
+			if err != nil {
+				if terr, ok := err.(twirp.Error); ok && hooks.Error != nil {
+					ctx = hooks.Error(ctx, terr)
+				}
+				return nil, err
+			}
+		}
+		res, err = next(ctx)
+		if terr, ok := err.(twirp.Error); ok && hooks.Error != nil {
+			ctx = hooks.Error(ctx, terr)
+		}
+		return res, err
+	})
+	return srv
+}
+
+// retryableCodes are the codes of errors that
+// clients may retry, preferably with a backoff.
+var retryableCodes = map[string]bool{
+	"aborted":            true,
+	"deadline_exceeded":  true,
+	"resource_exhausted": true,
+	"unavailable":        true,
+}
+
+// internalCodes are the codes of errors
+// that WithProduction hides from clients.
+var internalCodes = map[string]bool{
+	"data_loss": true,
+	"internal":  true,
+	"unknown":   true,
+}
+
+// errorPresenter returns the GraphQL errors of the resolvers with
+// the code, meta and retryable extensions of the service errors.
+// Errors that carry their own extensions, such as those of
+// hand-written resolvers, keep them, while the other errors
+// that are not service errors are internal errors.
+func errorPresenter(o *options) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		if o.errorMapper != nil {
+			if gqlErr := o.errorMapper(ctx, err); gqlErr != nil {
+				if gqlErr.Path == nil {
+					gqlErr.Path = graphql.GetFieldContext(ctx).Path()
+				}
+				return gqlErr
+			}
+		}
+		if _, ok := err.(*gqlerror.Error); ok {
+			return graphql.DefaultErrorPresenter(ctx, err)
+		}
+		var extendedErr graphql.ExtendedError
+		if errors.As(err, &extendedErr) {
+			return &gqlerror.Error{
+				Message:    err.Error(),
+				Path:       graphql.GetFieldContext(ctx).Path(),
+				Extensions: extendedErr.Extensions(),
+			}
+		}
+		code, msg, meta, ok := serviceError(err)
+		if !ok {
+			code, msg = "internal", err.Error()
+		}
+		if o.production && internalCodes[code] {
+			msg, meta = "internal error", nil
+		}
+		gqlErr := &gqlerror.Error{
+			Message: msg,
+			Path:    graphql.GetFieldContext(ctx).Path(),
+			Extensions: map[string]interface{}{
+				"code":      code,
+				"retryable": retryableCodes[code],
+			},
+		}
+		if len(meta) > 0 {
+			gqlErr.Extensions["meta"] = meta
+		}
+		return gqlErr
+	}
+}
+
+// serviceError returns the code, message and meta of a Twirp error.
+func serviceError(err error) (string, string, map[string]string, bool) {
+	var terr twirp.Error
+	if !errors.As(err, &terr) {
+		return "", "", nil, false
+	}
+	return string(terr.Code()), terr.Msg(), terr.MetaMap(), true
+}
@@ -0,0 +1,4 @@
+package grpcbackend
+
+//go:generate protoc -I . -I /usr/local/include -I ../.. --go_out=. --go-grpc_out=. service.proto
+//go:generate protoc -I . -I /usr/local/include -I ../.. --graphql_out=backend=grpc:. service.proto
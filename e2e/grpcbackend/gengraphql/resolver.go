@@ -0,0 +1,40 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package gengraphql
+
+import (
+	"context"
+
+	"github.com/tmc/protoc-gen-graphql/e2e/grpcbackend"
+)
+
+type Resolver struct {
+	grpcbackend.LibraryClient
+}
+
+func (r *Resolver) Book() BookResolver {
+	return &bookResolver{r}
+}
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{r}
+}
+
+type bookResolver struct{ *Resolver }
+
+func (r *bookResolver) Author(ctx context.Context, obj *grpcbackend.Book) (*grpcbackend.Author, error) {
+	return loadersFrom(ctx, r.LibraryClient).BatchGetAuthors.Load(ctx, obj.AuthorId)
+}
+
+type queryResolver struct{ *Resolver }
+
+func (r *queryResolver) GetBook(ctx context.Context, req *grpcbackend.GetBookReq) (*grpcbackend.Book, error) {
+	return r.LibraryClient.GetBook(ctx, req)
+}
+
+func (r *queryResolver) BatchGetAuthors(ctx context.Context, req *grpcbackend.BatchGetAuthorsReq) (*grpcbackend.BatchGetAuthorsResp, error) {
+	return r.LibraryClient.BatchGetAuthors(ctx, req)
+}
+
+func (r *queryResolver) Whoami(ctx context.Context) (*grpcbackend.WhoamiResp, error) {
+	return r.LibraryClient.Whoami(ctx, nil)
+}
@@ -0,0 +1,146 @@
+package grpcbackend_test
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/protoc-gen-graphql/e2e/grpcbackend"
+	"github.com/tmc/protoc-gen-graphql/e2e/grpcbackend/gengraphql"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestGetBook(t *testing.T) {
+	s := &library{books: map[string]*grpcbackend.Book{
+		"1": {Id: "1", Title: "one"},
+	}}
+	h := gengraphql.Handler(dial(t, s))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  getBook(req: {id: \"1\"}) {\n    title\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"getBook":{"title":"one"}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to call the gRPC server")
+}
+
+func TestGetBookError(t *testing.T) {
+	h := gengraphql.Handler(dial(t, &library{}))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  getBook(req: {id: \"1\"}) {\n    title\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"errors":[{"message":"no book 1","path":["getBook"],"extensions":{"code":"not_found","meta":{"id":"1"},"retryable":false}}],"data":null}`
+	require.Equal(t, expected, w.Body.String(), "Expected the gRPC status in the error extensions")
+}
+
+func TestLoaders(t *testing.T) {
+	s := &library{books: map[string]*grpcbackend.Book{
+		"1": {Id: "1", Title: "one", AuthorId: "ann"},
+	}}
+	h := gengraphql.Handler(dial(t, s))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  a: getBook(req: {id: \"1\"}) {\n    author {\n      name\n    }\n  }\n  b: getBook(req: {id: \"1\"}) {\n    author {\n      name\n    }\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"a":{"author":{"name":"ANN"}},"b":{"author":{"name":"ANN"}}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected GraphQL query to resolve the authors")
+	require.Len(t, s.batches, 1, "Expected a single batch for all authors")
+	require.Equal(t, []string{"ann"}, s.batches[0])
+}
+
+func TestMetadata(t *testing.T) {
+	s := &library{}
+	h := gengraphql.Handler(dial(t, s))
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  whoami {\n    user\n  }\n}\n"
+	}`))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-User", "ann")
+	h.ServeHTTP(w, req)
+
+	expected := `{"data":{"whoami":{"user":"ann"}}}`
+	require.Equal(t, expected, w.Body.String(), "Expected the headers to be forwarded as gRPC metadata")
+	require.Equal(t, []string{"application/grpc"}, s.md.Get("content-type"))
+}
+
+// dial serves s over an in-memory listener and
+// returns a client connection to it.
+func dial(t *testing.T, s grpcbackend.LibraryServer) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	grpcbackend.RegisterLibraryServer(srv, s)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+type library struct {
+	grpcbackend.UnimplementedLibraryServer
+
+	mu      sync.Mutex
+	books   map[string]*grpcbackend.Book
+	batches [][]string
+	md      metadata.MD
+}
+
+func (l *library) GetBook(ctx context.Context, req *grpcbackend.GetBookReq) (*grpcbackend.Book, error) {
+	book, ok := l.books[req.GetId()]
+	if !ok {
+		st, err := status.Newf(codes.NotFound, "no book %v", req.GetId()).WithDetails(&errdetails.ErrorInfo{
+			Metadata: map[string]string{"id": req.GetId()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return nil, st.Err()
+	}
+	return book, nil
+}
+
+func (l *library) BatchGetAuthors(ctx context.Context, req *grpcbackend.BatchGetAuthorsReq) (*grpcbackend.BatchGetAuthorsResp, error) {
+	l.mu.Lock()
+	l.batches = append(l.batches, req.GetIds())
+	l.mu.Unlock()
+	resp := &grpcbackend.BatchGetAuthorsResp{}
+	for _, id := range req.GetIds() {
+		resp.Authors = append(resp.Authors, &grpcbackend.Author{Id: id, Name: strings.ToUpper(id)})
+	}
+	return resp, nil
+}
+
+func (l *library) Whoami(ctx context.Context, req *grpcbackend.WhoamiReq) (*grpcbackend.WhoamiResp, error) {
+	l.md, _ = metadata.FromIncomingContext(ctx)
+	return &grpcbackend.WhoamiResp{User: strings.Join(l.md.Get("x-user"), ",")}, nil
+}
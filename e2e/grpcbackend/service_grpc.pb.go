@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.12.4
+// source: service.proto
+
+package grpcbackend
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Library_GetBook_FullMethodName         = "/grpcbackend.Library/GetBook"
+	Library_BatchGetAuthors_FullMethodName = "/grpcbackend.Library/BatchGetAuthors"
+	Library_Whoami_FullMethodName          = "/grpcbackend.Library/Whoami"
+)
+
+// LibraryClient is the client API for Library service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LibraryClient interface {
+	GetBook(ctx context.Context, in *GetBookReq, opts ...grpc.CallOption) (*Book, error)
+	BatchGetAuthors(ctx context.Context, in *BatchGetAuthorsReq, opts ...grpc.CallOption) (*BatchGetAuthorsResp, error)
+	Whoami(ctx context.Context, in *WhoamiReq, opts ...grpc.CallOption) (*WhoamiResp, error)
+}
+
+type libraryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLibraryClient(cc grpc.ClientConnInterface) LibraryClient {
+	return &libraryClient{cc}
+}
+
+func (c *libraryClient) GetBook(ctx context.Context, in *GetBookReq, opts ...grpc.CallOption) (*Book, error) {
+	out := new(Book)
+	err := c.cc.Invoke(ctx, Library_GetBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) BatchGetAuthors(ctx context.Context, in *BatchGetAuthorsReq, opts ...grpc.CallOption) (*BatchGetAuthorsResp, error) {
+	out := new(BatchGetAuthorsResp)
+	err := c.cc.Invoke(ctx, Library_BatchGetAuthors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *libraryClient) Whoami(ctx context.Context, in *WhoamiReq, opts ...grpc.CallOption) (*WhoamiResp, error) {
+	out := new(WhoamiResp)
+	err := c.cc.Invoke(ctx, Library_Whoami_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LibraryServer is the server API for Library service.
+// All implementations must embed UnimplementedLibraryServer
+// for forward compatibility
+type LibraryServer interface {
+	GetBook(context.Context, *GetBookReq) (*Book, error)
+	BatchGetAuthors(context.Context, *BatchGetAuthorsReq) (*BatchGetAuthorsResp, error)
+	Whoami(context.Context, *WhoamiReq) (*WhoamiResp, error)
+	mustEmbedUnimplementedLibraryServer()
+}
+
+// UnimplementedLibraryServer must be embedded to have forward compatible implementations.
+type UnimplementedLibraryServer struct {
+}
+
+func (UnimplementedLibraryServer) GetBook(context.Context, *GetBookReq) (*Book, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBook not implemented")
+}
+func (UnimplementedLibraryServer) BatchGetAuthors(context.Context, *BatchGetAuthorsReq) (*BatchGetAuthorsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetAuthors not implemented")
+}
+func (UnimplementedLibraryServer) Whoami(context.Context, *WhoamiReq) (*WhoamiResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Whoami not implemented")
+}
+func (UnimplementedLibraryServer) mustEmbedUnimplementedLibraryServer() {}
+
+// UnsafeLibraryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LibraryServer will
+// result in compilation errors.
+type UnsafeLibraryServer interface {
+	mustEmbedUnimplementedLibraryServer()
+}
+
+func RegisterLibraryServer(s grpc.ServiceRegistrar, srv LibraryServer) {
+	s.RegisterService(&Library_ServiceDesc, srv)
+}
+
+func _Library_GetBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).GetBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_GetBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).GetBook(ctx, req.(*GetBookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_BatchGetAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetAuthorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).BatchGetAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_BatchGetAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).BatchGetAuthors(ctx, req.(*BatchGetAuthorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Library_Whoami_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhoamiReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LibraryServer).Whoami(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Library_Whoami_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LibraryServer).Whoami(ctx, req.(*WhoamiReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Library_ServiceDesc is the grpc.ServiceDesc for Library service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Library_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcbackend.Library",
+	HandlerType: (*LibraryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBook",
+			Handler:    _Library_GetBook_Handler,
+		},
+		{
+			MethodName: "BatchGetAuthors",
+			Handler:    _Library_BatchGetAuthors_Handler,
+		},
+		{
+			MethodName: "Whoami",
+			Handler:    _Library_Whoami_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
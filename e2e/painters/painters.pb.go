@@ -72,17 +72,75 @@ func (x *Painter) GetName() string {
 	return ""
 }
 
+type NotAPainter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NotAPainter) Reset() {
+	*x = NotAPainter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_painters_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotAPainter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotAPainter) ProtoMessage() {}
+
+func (x *NotAPainter) ProtoReflect() protoreflect.Message {
+	mi := &file_painters_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotAPainter.ProtoReflect.Descriptor instead.
+func (*NotAPainter) Descriptor() ([]byte, []int) {
+	return file_painters_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotAPainter) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NotAPainter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 var File_painters_proto protoreflect.FileDescriptor
 
 var file_painters_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x70, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x08, 0x70, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1d, 0x0a, 0x07, 0x50, 0x61,
 	0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6d, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x2f, 0x65,
-	0x32, 0x65, 0x2f, 0x70, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x0b, 0x4e, 0x6f, 0x74,
+	0x41, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x6d, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x67, 0x72, 0x61, 0x70, 0x68, 0x71, 0x6c, 0x2f, 0x65, 0x32, 0x65, 0x2f,
+	0x70, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -97,9 +155,10 @@ func file_painters_proto_rawDescGZIP() []byte {
 	return file_painters_proto_rawDescData
 }
 
-var file_painters_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_painters_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_painters_proto_goTypes = []interface{}{
-	(*Painter)(nil), // 0: painters.Painter
+	(*Painter)(nil),     // 0: painters.Painter
+	(*NotAPainter)(nil), // 1: painters.NotAPainter
 }
 var file_painters_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -127,6 +186,18 @@ func file_painters_proto_init() {
 				return nil
 			}
 		}
+		file_painters_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotAPainter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -134,7 +205,7 @@ func file_painters_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_painters_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,4 @@
+package proto2
+
+//go:generate protoc -I . -I /usr/local/include -I ../.. --go_out=. --twirp_out=. service.proto
+//go:generate protoc -I . -I /usr/local/include -I ../.. --graphql_out=. service.proto
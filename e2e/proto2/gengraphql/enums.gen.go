@@ -0,0 +1,32 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package gengraphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/tmc/protoc-gen-graphql/e2e/proto2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func (ec *executionContext) _Greeting(ctx context.Context, sel ast.SelectionSet, v *proto2.Greeting) graphql.Marshaler {
+	if _, ok := proto2.Greeting_name[int32(*v)]; !ok {
+		ec.Errorf(ctx, "unknown value %d for enum Greeting", *v)
+		return graphql.Null
+	}
+	return graphql.MarshalString((*v).String())
+}
+
+func (ec *executionContext) unmarshalInputGreeting(ctx context.Context, v interface{}) (proto2.Greeting, error) {
+	switch v := v.(type) {
+	case string:
+		intValue, ok := proto2.Greeting_value[v]
+		if !ok {
+			return 0, errors.New("unknown value: " + v)
+		}
+		return proto2.Greeting(intValue), nil
+	}
+	return 0, errors.New("wrong type")
+}
@@ -0,0 +1,23 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package gengraphql
+
+import (
+	"context"
+
+	"github.com/tmc/protoc-gen-graphql/e2e/proto2"
+)
+
+type Resolver struct {
+	proto2.Greeter
+}
+
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{r}
+}
+
+type queryResolver struct{ *Resolver }
+
+func (r *queryResolver) Hello(ctx context.Context, req *proto2.HelloReq) (*proto2.HelloResp, error) {
+	return r.Greeter.Hello(ctx, req)
+}
@@ -0,0 +1,70 @@
+package proto2_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tmc/protoc-gen-graphql/e2e/proto2"
+	"github.com/tmc/protoc-gen-graphql/e2e/proto2/gengraphql"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestOptionalFieldsSet(t *testing.T) {
+	s := &greeter{resp: &proto2.HelloResp{
+		Text:  proto.String("hello"),
+		Count: proto.Int64(0),
+		Note:  proto.String(""),
+		Meta:  &proto2.Meta{Id: proto.String("m")},
+	}}
+	body := query(t, s, `{name: \"ann\", title: \"Ms.\", times: 0, greeting: HI, loud: false}`)
+
+	expected := `{"data":{"hello":{"text":"hello","count":0,"note":"","meta":{"id":"m"}}}}`
+	require.Equal(t, expected, body, "Expected set optional fields, zero values included, to be returned")
+	require.Equal(t, "ann", s.req.GetName())
+	require.Equal(t, "Ms.", s.req.GetTitle())
+	require.NotNil(t, s.req.Times, "Expected an explicit zero to be set")
+	require.Equal(t, int32(0), s.req.GetTimes())
+	require.NotNil(t, s.req.Greeting)
+	require.Equal(t, proto2.Greeting_HI, s.req.GetGreeting())
+	require.NotNil(t, s.req.Loud, "Expected an explicit false to be set")
+}
+
+func TestOptionalFieldsUnset(t *testing.T) {
+	s := &greeter{resp: &proto2.HelloResp{Text: proto.String("hello")}}
+	body := query(t, s, `{name: \"ann\"}`)
+
+	expected := `{"data":{"hello":{"text":"hello","count":null,"note":null,"meta":null}}}`
+	require.Equal(t, expected, body, "Expected unset optional fields to be null")
+	require.Nil(t, s.req.Times, "Expected an omitted field without a default to stay unset")
+	require.Nil(t, s.req.Loud, "Expected an omitted field without a default to stay unset")
+	require.Equal(t, "Dr.", s.req.GetTitle(), "Expected the proto2 default of an omitted field")
+	require.Equal(t, proto2.Greeting_HEY, s.req.GetGreeting(), "Expected the proto2 default of an omitted enum")
+}
+
+// query sends the hello query with the given request
+// to the handler of s and returns the response body.
+func query(t *testing.T, s *greeter, req string) string {
+	t.Helper()
+	h := gengraphql.Handler(s, nil)
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("POST", "/", strings.NewReader(`{
+		"operationName": "q",
+		"query": "query q {\n  hello(req: `+req+`) {\n    text\n    count\n    note\n    meta {\n      id\n    }\n  }\n}\n"
+	}`))
+	r.Header.Add("Content-Type", "application/json")
+	h.ServeHTTP(w, r)
+	return w.Body.String()
+}
+
+type greeter struct {
+	req  *proto2.HelloReq
+	resp *proto2.HelloResp
+}
+
+func (g *greeter) Hello(ctx context.Context, req *proto2.HelloReq) (*proto2.HelloResp, error) {
+	g.req = req
+	return g.resp, nil
+}
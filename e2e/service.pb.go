@@ -792,6 +792,636 @@ func (*ChangeMeResp_NewName) isChangeMeResp_Answer() {}
 
 func (*ChangeMeResp_Changed) isChangeMeResp_Answer() {}
 
+type ListBooksReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shelf     string `protobuf:"bytes,1,opt,name=shelf,proto3" json:"shelf,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListBooksReq) Reset() {
+	*x = ListBooksReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBooksReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBooksReq) ProtoMessage() {}
+
+func (x *ListBooksReq) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBooksReq.ProtoReflect.Descriptor instead.
+func (*ListBooksReq) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListBooksReq) GetShelf() string {
+	if x != nil {
+		return x.Shelf
+	}
+	return ""
+}
+
+func (x *ListBooksReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBooksReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListBooksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Books         []*Book `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListBooksResp) Reset() {
+	*x = ListBooksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBooksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBooksResp) ProtoMessage() {}
+
+func (x *ListBooksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBooksResp.ProtoReflect.Descriptor instead.
+func (*ListBooksResp) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListBooksResp) GetBooks() []*Book {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *ListBooksResp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Book struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title    string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	AuthorId string `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	EditorId string `protobuf:"bytes,3,opt,name=editor_id,json=editorId,proto3" json:"editor_id,omitempty"`
+}
+
+func (x *Book) Reset() {
+	*x = Book{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Book) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Book) ProtoMessage() {}
+
+func (x *Book) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Book.ProtoReflect.Descriptor instead.
+func (*Book) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Book) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Book) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Book) GetEditorId() string {
+	if x != nil {
+		return x.EditorId
+	}
+	return ""
+}
+
+type BatchGetAuthorsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *BatchGetAuthorsReq) Reset() {
+	*x = BatchGetAuthorsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetAuthorsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAuthorsReq) ProtoMessage() {}
+
+func (x *BatchGetAuthorsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAuthorsReq.ProtoReflect.Descriptor instead.
+func (*BatchGetAuthorsReq) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchGetAuthorsReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetAuthorsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authors []*Author `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+}
+
+func (x *BatchGetAuthorsResp) Reset() {
+	*x = BatchGetAuthorsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetAuthorsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetAuthorsResp) ProtoMessage() {}
+
+func (x *BatchGetAuthorsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetAuthorsResp.ProtoReflect.Descriptor instead.
+func (*BatchGetAuthorsResp) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchGetAuthorsResp) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type GetAuthorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetAuthorReq) Reset() {
+	*x = GetAuthorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAuthorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorReq) ProtoMessage() {}
+
+func (x *GetAuthorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorReq.ProtoReflect.Descriptor instead.
+func (*GetAuthorReq) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAuthorReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Author struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Author) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GreetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Times      int32            `protobuf:"varint,2,opt,name=times,proto3" json:"times,omitempty"`
+	Tags       []string         `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Light      TrafficLight     `protobuf:"varint,4,opt,name=light,proto3,enum=e2e.TrafficLight" json:"light,omitempty"`
+	Word       *Word            `protobuf:"bytes,5,opt,name=word,proto3" json:"word,omitempty"`
+	Dictionary map[string]*Word `protobuf:"bytes,6,rep,name=dictionary,proto3" json:"dictionary,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// req is named like the request that the resolver rebuilds.
+	Req string `protobuf:"bytes,7,opt,name=req,proto3" json:"req,omitempty"`
+}
+
+func (x *GreetReq) Reset() {
+	*x = GreetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GreetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GreetReq) ProtoMessage() {}
+
+func (x *GreetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GreetReq.ProtoReflect.Descriptor instead.
+func (*GreetReq) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GreetReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GreetReq) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+func (x *GreetReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GreetReq) GetLight() TrafficLight {
+	if x != nil {
+		return x.Light
+	}
+	return TrafficLight_RED
+}
+
+func (x *GreetReq) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *GreetReq) GetDictionary() map[string]*Word {
+	if x != nil {
+		return x.Dictionary
+	}
+	return nil
+}
+
+func (x *GreetReq) GetReq() string {
+	if x != nil {
+		return x.Req
+	}
+	return ""
+}
+
+type PaintReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Painter string `protobuf:"bytes,1,opt,name=painter,proto3" json:"painter,omitempty"`
+	Color   string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *PaintReq) Reset() {
+	*x = PaintReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaintReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaintReq) ProtoMessage() {}
+
+func (x *PaintReq) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaintReq.ProtoReflect.Descriptor instead.
+func (*PaintReq) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PaintReq) GetPainter() string {
+	if x != nil {
+		return x.Painter
+	}
+	return ""
+}
+
+func (x *PaintReq) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type PaintResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Painting string `protobuf:"bytes,1,opt,name=painting,proto3" json:"painting,omitempty"`
+}
+
+func (x *PaintResp) Reset() {
+	*x = PaintResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaintResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaintResp) ProtoMessage() {}
+
+func (x *PaintResp) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaintResp.ProtoReflect.Descriptor instead.
+func (*PaintResp) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PaintResp) GetPainting() string {
+	if x != nil {
+		return x.Painting
+	}
+	return ""
+}
+
+type OutOfPaint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Color   string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *OutOfPaint) Reset() {
+	*x = OutOfPaint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutOfPaint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutOfPaint) ProtoMessage() {}
+
+func (x *OutOfPaint) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutOfPaint.ProtoReflect.Descriptor instead.
+func (*OutOfPaint) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OutOfPaint) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OutOfPaint) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
 var File_service_proto protoreflect.FileDescriptor
 
 var file_service_proto_rawDesc = []byte{
@@ -877,30 +1507,114 @@ var file_service_proto_rawDesc = []byte{
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
 	0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x2a, 0x2e, 0x0a, 0x0c, 0x54,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x52,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x59, 0x45, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01,
-	0x12, 0x09, 0x0a, 0x05, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x02, 0x32, 0xb0, 0x02, 0x0a, 0x07,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
-	0x12, 0x0d, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x1a,
-	0x0e, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x35, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4a, 0x61, 0x6d, 0x12, 0x12, 0x2e,
-	0x65, 0x32, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4a, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x1a, 0x13, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4a,
-	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x50, 0x61,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x09, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x65, 0x32, 0x65,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26,
-	0x0a, 0x05, 0x42, 0x72, 0x65, 0x61, 0x64, 0x12, 0x0d, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x42, 0x72,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x42, 0x72, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x4d, 0x65, 0x12, 0x10, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x05, 0xf2, 0x42, 0x02, 0x08, 0x01, 0x42, 0x07,
-	0x5a, 0x05, 0x2e, 0x3b, 0x65, 0x32, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x68, 0x65, 0x6c, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c,
+	0x66, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x58, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1f,
+	0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x65, 0x32, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xf2, 0x42, 0x1e, 0x0a, 0x1c,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x0f, 0x0a, 0x02, 0x69,
+	0x64, 0x12, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x52, 0x08, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xf2, 0x42, 0x25, 0x0a, 0x23,
+	0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x12, 0x09, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x26, 0x0a,
+	0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x3c, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x07,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x65, 0x32, 0x65, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x73, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x06, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x3a, 0x14, 0xf2, 0x42, 0x11, 0x0a, 0x0f, 0x12, 0x09, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x0a, 0x02, 0x69, 0x64, 0x22, 0xab, 0x02, 0x0a, 0x08, 0x47, 0x72, 0x65, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4c,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x65, 0x32, 0x65, 0x2e,
+	0x57, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x65, 0x32, 0x65, 0x2e, 0x47, 0x72, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x2e, 0x44, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x71,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x71, 0x1a, 0x48, 0x0a, 0x0f, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3a, 0x0a, 0x08, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x22, 0x27, 0x0a, 0x09, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x3c, 0x0a, 0x0a, 0x4f, 0x75,
+	0x74, 0x4f, 0x66, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x2a, 0x2e, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x59, 0x45, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x02, 0x32, 0xe5, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x0d, 0x2e,
+	0x65, 0x32, 0x65, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x65,
+	0x32, 0x65, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x0a,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4a, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x65, 0x32, 0x65,
+	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4a, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x13,
+	0x2e, 0x65, 0x32, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x4a, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x10, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x05, 0x42,
+	0x72, 0x65, 0x61, 0x64, 0x12, 0x0d, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x08, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x12,
+	0x10, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x05, 0xf2, 0x42, 0x02, 0x08, 0x01, 0x12, 0x39, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x65, 0x32,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x05, 0xf2, 0x42, 0x02, 0x20, 0x01, 0x12, 0x2b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x11, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0b, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x18, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x05, 0xf2, 0x42, 0x02, 0x30, 0x01,
+	0x12, 0x2d, 0x0a, 0x05, 0x47, 0x72, 0x65, 0x65, 0x74, 0x12, 0x0d, 0x2e, 0x65, 0x32, 0x65, 0x2e,
+	0x47, 0x72, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x48,
+	0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x22, 0x05, 0xf2, 0x42, 0x02, 0x28, 0x01, 0x12,
+	0x4f, 0x0a, 0x05, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x0d, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x50,
+	0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x65, 0x32, 0x65, 0x2e, 0x50, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x27, 0xf2, 0x42, 0x24, 0x08, 0x01, 0x1a, 0x0a,
+	0x4f, 0x75, 0x74, 0x4f, 0x66, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x1a, 0x14, 0x70, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x41, 0x50, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x42, 0x0c, 0x5a, 0x05, 0x2e, 0x3b, 0x65, 0x32, 0x65, 0xf2, 0x42, 0x02, 0x08, 0x01, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -916,58 +1630,86 @@ func file_service_proto_rawDescGZIP() []byte {
 }
 
 var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_service_proto_goTypes = []interface{}{
-	(TrafficLight)(0),        // 0: e2e.TrafficLight
-	(*HelloReq)(nil),         // 1: e2e.HelloReq
-	(*HelloResp)(nil),        // 2: e2e.HelloResp
-	(*TrafficJamReq)(nil),    // 3: e2e.TrafficJamReq
-	(*TrafficJamResp)(nil),   // 4: e2e.TrafficJamResp
-	(*PaintersReq)(nil),      // 5: e2e.PaintersReq
-	(*PaintersResp)(nil),     // 6: e2e.PaintersResp
-	(*TranslateResp)(nil),    // 7: e2e.TranslateResp
-	(*Word)(nil),             // 8: e2e.Word
-	(*TranslateReq)(nil),     // 9: e2e.TranslateReq
-	(*BreadReq)(nil),         // 10: e2e.BreadReq
-	(*BreadResp)(nil),        // 11: e2e.BreadResp
-	(*ChangeMeReq)(nil),      // 12: e2e.ChangeMeReq
-	(*ChangeMeResp)(nil),     // 13: e2e.ChangeMeResp
-	nil,                      // 14: e2e.TranslateResp.TranslationsEntry
-	nil,                      // 15: e2e.TranslateReq.WordsEntry
-	nil,                      // 16: e2e.ChangeMeReq.PreviousEntry
-	nil,                      // 17: e2e.ChangeMeResp.PreviousEntry
-	(*painters.Painter)(nil), // 18: painters.Painter
+	(TrafficLight)(0),           // 0: e2e.TrafficLight
+	(*HelloReq)(nil),            // 1: e2e.HelloReq
+	(*HelloResp)(nil),           // 2: e2e.HelloResp
+	(*TrafficJamReq)(nil),       // 3: e2e.TrafficJamReq
+	(*TrafficJamResp)(nil),      // 4: e2e.TrafficJamResp
+	(*PaintersReq)(nil),         // 5: e2e.PaintersReq
+	(*PaintersResp)(nil),        // 6: e2e.PaintersResp
+	(*TranslateResp)(nil),       // 7: e2e.TranslateResp
+	(*Word)(nil),                // 8: e2e.Word
+	(*TranslateReq)(nil),        // 9: e2e.TranslateReq
+	(*BreadReq)(nil),            // 10: e2e.BreadReq
+	(*BreadResp)(nil),           // 11: e2e.BreadResp
+	(*ChangeMeReq)(nil),         // 12: e2e.ChangeMeReq
+	(*ChangeMeResp)(nil),        // 13: e2e.ChangeMeResp
+	(*ListBooksReq)(nil),        // 14: e2e.ListBooksReq
+	(*ListBooksResp)(nil),       // 15: e2e.ListBooksResp
+	(*Book)(nil),                // 16: e2e.Book
+	(*BatchGetAuthorsReq)(nil),  // 17: e2e.BatchGetAuthorsReq
+	(*BatchGetAuthorsResp)(nil), // 18: e2e.BatchGetAuthorsResp
+	(*GetAuthorReq)(nil),        // 19: e2e.GetAuthorReq
+	(*Author)(nil),              // 20: e2e.Author
+	(*GreetReq)(nil),            // 21: e2e.GreetReq
+	(*PaintReq)(nil),            // 22: e2e.PaintReq
+	(*PaintResp)(nil),           // 23: e2e.PaintResp
+	(*OutOfPaint)(nil),          // 24: e2e.OutOfPaint
+	nil,                         // 25: e2e.TranslateResp.TranslationsEntry
+	nil,                         // 26: e2e.TranslateReq.WordsEntry
+	nil,                         // 27: e2e.ChangeMeReq.PreviousEntry
+	nil,                         // 28: e2e.ChangeMeResp.PreviousEntry
+	nil,                         // 29: e2e.GreetReq.DictionaryEntry
+	(*painters.Painter)(nil),    // 30: painters.Painter
 }
 var file_service_proto_depIdxs = []int32{
 	0,  // 0: e2e.TrafficJamReq.color:type_name -> e2e.TrafficLight
 	0,  // 1: e2e.TrafficJamReq.trafficLights:type_name -> e2e.TrafficLight
 	0,  // 2: e2e.TrafficJamResp.next:type_name -> e2e.TrafficLight
-	18, // 3: e2e.PaintersResp.bestPainter:type_name -> painters.Painter
-	14, // 4: e2e.TranslateResp.translations:type_name -> e2e.TranslateResp.TranslationsEntry
-	15, // 5: e2e.TranslateReq.words:type_name -> e2e.TranslateReq.WordsEntry
-	16, // 6: e2e.ChangeMeReq.previous:type_name -> e2e.ChangeMeReq.PreviousEntry
-	17, // 7: e2e.ChangeMeResp.previous:type_name -> e2e.ChangeMeResp.PreviousEntry
-	8,  // 8: e2e.TranslateResp.TranslationsEntry.value:type_name -> e2e.Word
-	8,  // 9: e2e.TranslateReq.WordsEntry.value:type_name -> e2e.Word
-	13, // 10: e2e.ChangeMeReq.PreviousEntry.value:type_name -> e2e.ChangeMeResp
-	13, // 11: e2e.ChangeMeResp.PreviousEntry.value:type_name -> e2e.ChangeMeResp
-	1,  // 12: e2e.Service.Hello:input_type -> e2e.HelloReq
-	3,  // 13: e2e.Service.TrafficJam:input_type -> e2e.TrafficJamReq
-	5,  // 14: e2e.Service.GetPainters:input_type -> e2e.PaintersReq
-	9,  // 15: e2e.Service.Translate:input_type -> e2e.TranslateReq
-	10, // 16: e2e.Service.Bread:input_type -> e2e.BreadReq
-	12, // 17: e2e.Service.ChangeMe:input_type -> e2e.ChangeMeReq
-	2,  // 18: e2e.Service.Hello:output_type -> e2e.HelloResp
-	4,  // 19: e2e.Service.TrafficJam:output_type -> e2e.TrafficJamResp
-	6,  // 20: e2e.Service.GetPainters:output_type -> e2e.PaintersResp
-	7,  // 21: e2e.Service.Translate:output_type -> e2e.TranslateResp
-	11, // 22: e2e.Service.Bread:output_type -> e2e.BreadResp
-	13, // 23: e2e.Service.ChangeMe:output_type -> e2e.ChangeMeResp
-	18, // [18:24] is the sub-list for method output_type
-	12, // [12:18] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	30, // 3: e2e.PaintersResp.bestPainter:type_name -> painters.Painter
+	25, // 4: e2e.TranslateResp.translations:type_name -> e2e.TranslateResp.TranslationsEntry
+	26, // 5: e2e.TranslateReq.words:type_name -> e2e.TranslateReq.WordsEntry
+	27, // 6: e2e.ChangeMeReq.previous:type_name -> e2e.ChangeMeReq.PreviousEntry
+	28, // 7: e2e.ChangeMeResp.previous:type_name -> e2e.ChangeMeResp.PreviousEntry
+	16, // 8: e2e.ListBooksResp.books:type_name -> e2e.Book
+	20, // 9: e2e.BatchGetAuthorsResp.authors:type_name -> e2e.Author
+	0,  // 10: e2e.GreetReq.light:type_name -> e2e.TrafficLight
+	8,  // 11: e2e.GreetReq.word:type_name -> e2e.Word
+	29, // 12: e2e.GreetReq.dictionary:type_name -> e2e.GreetReq.DictionaryEntry
+	8,  // 13: e2e.TranslateResp.TranslationsEntry.value:type_name -> e2e.Word
+	8,  // 14: e2e.TranslateReq.WordsEntry.value:type_name -> e2e.Word
+	13, // 15: e2e.ChangeMeReq.PreviousEntry.value:type_name -> e2e.ChangeMeResp
+	13, // 16: e2e.ChangeMeResp.PreviousEntry.value:type_name -> e2e.ChangeMeResp
+	8,  // 17: e2e.GreetReq.DictionaryEntry.value:type_name -> e2e.Word
+	1,  // 18: e2e.Service.Hello:input_type -> e2e.HelloReq
+	3,  // 19: e2e.Service.TrafficJam:input_type -> e2e.TrafficJamReq
+	5,  // 20: e2e.Service.GetPainters:input_type -> e2e.PaintersReq
+	9,  // 21: e2e.Service.Translate:input_type -> e2e.TranslateReq
+	10, // 22: e2e.Service.Bread:input_type -> e2e.BreadReq
+	12, // 23: e2e.Service.ChangeMe:input_type -> e2e.ChangeMeReq
+	14, // 24: e2e.Service.ListBooks:input_type -> e2e.ListBooksReq
+	19, // 25: e2e.Service.GetAuthor:input_type -> e2e.GetAuthorReq
+	17, // 26: e2e.Service.BatchGetAuthors:input_type -> e2e.BatchGetAuthorsReq
+	21, // 27: e2e.Service.Greet:input_type -> e2e.GreetReq
+	22, // 28: e2e.Service.Paint:input_type -> e2e.PaintReq
+	2,  // 29: e2e.Service.Hello:output_type -> e2e.HelloResp
+	4,  // 30: e2e.Service.TrafficJam:output_type -> e2e.TrafficJamResp
+	6,  // 31: e2e.Service.GetPainters:output_type -> e2e.PaintersResp
+	7,  // 32: e2e.Service.Translate:output_type -> e2e.TranslateResp
+	11, // 33: e2e.Service.Bread:output_type -> e2e.BreadResp
+	13, // 34: e2e.Service.ChangeMe:output_type -> e2e.ChangeMeResp
+	15, // 35: e2e.Service.ListBooks:output_type -> e2e.ListBooksResp
+	20, // 36: e2e.Service.GetAuthor:output_type -> e2e.Author
+	18, // 37: e2e.Service.BatchGetAuthors:output_type -> e2e.BatchGetAuthorsResp
+	2,  // 38: e2e.Service.Greet:output_type -> e2e.HelloResp
+	23, // 39: e2e.Service.Paint:output_type -> e2e.PaintResp
+	29, // [29:40] is the sub-list for method output_type
+	18, // [18:29] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -1132,6 +1874,138 @@ func file_service_proto_init() {
 				return nil
 			}
 		}
+		file_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBooksReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBooksResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Book); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetAuthorsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetAuthorsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Author); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GreetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaintReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaintResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutOfPaint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_service_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*BreadResp_Name)(nil),
@@ -1147,7 +2021,7 @@ func file_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_service_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   17,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -44,6 +44,16 @@ type Service interface {
 	Bread(context.Context, *BreadReq) (*BreadResp, error)
 
 	ChangeMe(context.Context, *ChangeMeReq) (*ChangeMeResp, error)
+
+	ListBooks(context.Context, *ListBooksReq) (*ListBooksResp, error)
+
+	GetAuthor(context.Context, *GetAuthorReq) (*Author, error)
+
+	BatchGetAuthors(context.Context, *BatchGetAuthorsReq) (*BatchGetAuthorsResp, error)
+
+	Greet(context.Context, *GreetReq) (*HelloResp, error)
+
+	Paint(context.Context, *PaintReq) (*PaintResp, error)
 }
 
 // =======================
@@ -52,7 +62,7 @@ type Service interface {
 
 type serviceProtobufClient struct {
 	client HTTPClient
-	urls   [6]string
+	urls   [11]string
 	opts   twirp.ClientOptions
 }
 
@@ -69,13 +79,18 @@ func NewServiceProtobufClient(addr string, client HTTPClient, opts ...twirp.Clie
 	}
 
 	prefix := urlBase(addr) + ServicePathPrefix
-	urls := [6]string{
+	urls := [11]string{
 		prefix + "Hello",
 		prefix + "TrafficJam",
 		prefix + "GetPainters",
 		prefix + "Translate",
 		prefix + "Bread",
 		prefix + "ChangeMe",
+		prefix + "ListBooks",
+		prefix + "GetAuthor",
+		prefix + "BatchGetAuthors",
+		prefix + "Greet",
+		prefix + "Paint",
 	}
 
 	return &serviceProtobufClient{
@@ -205,13 +220,113 @@ func (c *serviceProtobufClient) ChangeMe(ctx context.Context, in *ChangeMeReq) (
 	return out, nil
 }
 
+func (c *serviceProtobufClient) ListBooks(ctx context.Context, in *ListBooksReq) (*ListBooksResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "ListBooks")
+	out := new(ListBooksResp)
+	err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *serviceProtobufClient) GetAuthor(ctx context.Context, in *GetAuthorReq) (*Author, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuthor")
+	out := new(Author)
+	err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *serviceProtobufClient) BatchGetAuthors(ctx context.Context, in *BatchGetAuthorsReq) (*BatchGetAuthorsResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "BatchGetAuthors")
+	out := new(BatchGetAuthorsResp)
+	err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *serviceProtobufClient) Greet(ctx context.Context, in *GreetReq) (*HelloResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "Greet")
+	out := new(HelloResp)
+	err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *serviceProtobufClient) Paint(ctx context.Context, in *PaintReq) (*PaintResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "Paint")
+	out := new(PaintResp)
+	err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===================
 // Service JSON Client
 // ===================
 
 type serviceJSONClient struct {
 	client HTTPClient
-	urls   [6]string
+	urls   [11]string
 	opts   twirp.ClientOptions
 }
 
@@ -228,13 +343,18 @@ func NewServiceJSONClient(addr string, client HTTPClient, opts ...twirp.ClientOp
 	}
 
 	prefix := urlBase(addr) + ServicePathPrefix
-	urls := [6]string{
+	urls := [11]string{
 		prefix + "Hello",
 		prefix + "TrafficJam",
 		prefix + "GetPainters",
 		prefix + "Translate",
 		prefix + "Bread",
 		prefix + "ChangeMe",
+		prefix + "ListBooks",
+		prefix + "GetAuthor",
+		prefix + "BatchGetAuthors",
+		prefix + "Greet",
+		prefix + "Paint",
 	}
 
 	return &serviceJSONClient{
@@ -364,6 +484,106 @@ func (c *serviceJSONClient) ChangeMe(ctx context.Context, in *ChangeMeReq) (*Cha
 	return out, nil
 }
 
+func (c *serviceJSONClient) ListBooks(ctx context.Context, in *ListBooksReq) (*ListBooksResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "ListBooks")
+	out := new(ListBooksResp)
+	err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[6], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *serviceJSONClient) GetAuthor(ctx context.Context, in *GetAuthorReq) (*Author, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuthor")
+	out := new(Author)
+	err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[7], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *serviceJSONClient) BatchGetAuthors(ctx context.Context, in *BatchGetAuthorsReq) (*BatchGetAuthorsResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "BatchGetAuthors")
+	out := new(BatchGetAuthorsResp)
+	err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[8], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *serviceJSONClient) Greet(ctx context.Context, in *GreetReq) (*HelloResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "Greet")
+	out := new(HelloResp)
+	err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[9], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
+func (c *serviceJSONClient) Paint(ctx context.Context, in *PaintReq) (*PaintResp, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "e2e")
+	ctx = ctxsetters.WithServiceName(ctx, "Service")
+	ctx = ctxsetters.WithMethodName(ctx, "Paint")
+	out := new(PaintResp)
+	err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[10], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ======================
 // Service Server Handler
 // ======================
@@ -430,6 +650,21 @@ func (s *serviceServer) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	case "/twirp/e2e.Service/ChangeMe":
 		s.serveChangeMe(ctx, resp, req)
 		return
+	case "/twirp/e2e.Service/ListBooks":
+		s.serveListBooks(ctx, resp, req)
+		return
+	case "/twirp/e2e.Service/GetAuthor":
+		s.serveGetAuthor(ctx, resp, req)
+		return
+	case "/twirp/e2e.Service/BatchGetAuthors":
+		s.serveBatchGetAuthors(ctx, resp, req)
+		return
+	case "/twirp/e2e.Service/Greet":
+		s.serveGreet(ctx, resp, req)
+		return
+	case "/twirp/e2e.Service/Paint":
+		s.servePaint(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		err = badRouteError(msg, req.Method, req.URL.Path)
@@ -1212,6 +1447,651 @@ func (s *serviceServer) serveChangeMeProtobuf(ctx context.Context, resp http.Res
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *serviceServer) serveListBooks(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveListBooksJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveListBooksProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *serviceServer) serveListBooksJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListBooks")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(ListBooksReq)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *ListBooksResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.ListBooks(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListBooksResp and nil error while calling ListBooks. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) serveListBooksProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "ListBooks")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(ListBooksReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *ListBooksResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.ListBooks(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *ListBooksResp and nil error while calling ListBooks. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) serveGetAuthor(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetAuthorJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetAuthorProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *serviceServer) serveGetAuthorJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuthor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(GetAuthorReq)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *Author
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.GetAuthor(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Author and nil error while calling GetAuthor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) serveGetAuthorProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetAuthor")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(GetAuthorReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *Author
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.GetAuthor(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *Author and nil error while calling GetAuthor. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) serveBatchGetAuthors(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveBatchGetAuthorsJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveBatchGetAuthorsProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *serviceServer) serveBatchGetAuthorsJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BatchGetAuthors")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(BatchGetAuthorsReq)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *BatchGetAuthorsResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.BatchGetAuthors(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *BatchGetAuthorsResp and nil error while calling BatchGetAuthors. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) serveBatchGetAuthorsProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "BatchGetAuthors")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(BatchGetAuthorsReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *BatchGetAuthorsResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.BatchGetAuthors(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *BatchGetAuthorsResp and nil error while calling BatchGetAuthors. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) serveGreet(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGreetJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGreetProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *serviceServer) serveGreetJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Greet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(GreetReq)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *HelloResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.Greet(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HelloResp and nil error while calling Greet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) serveGreetProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Greet")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(GreetReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *HelloResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.Greet(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *HelloResp and nil error while calling Greet. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) servePaint(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.servePaintJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.servePaintProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *serviceServer) servePaintJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Paint")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(PaintReq)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *PaintResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.Paint(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PaintResp and nil error while calling Paint. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *serviceServer) servePaintProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "Paint")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(PaintReq)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	// Call service method
+	var respContent *PaintResp
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = s.Service.Paint(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *PaintResp and nil error while calling Paint. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *serviceServer) ServiceDescriptor() ([]byte, int) {
 	return twirpFileDescriptor0, 0
 }
@@ -1737,49 +2617,81 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 697 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x51, 0x6f, 0xd3, 0x30,
-	0x10, 0x5e, 0x9a, 0x66, 0x4d, 0x2e, 0xcd, 0xd4, 0x1e, 0x93, 0xa8, 0x22, 0xb4, 0x55, 0x11, 0xb0,
-	0x89, 0x87, 0x22, 0x65, 0x62, 0x43, 0xe3, 0xad, 0xa3, 0x5a, 0x35, 0x8d, 0x31, 0x05, 0xa4, 0x09,
-	0xde, 0xb2, 0xd6, 0xeb, 0x2a, 0xb2, 0x24, 0x8d, 0xdd, 0x8d, 0xfd, 0x02, 0x7e, 0x09, 0x8f, 0x48,
-	0xfc, 0x35, 0xf8, 0x05, 0xc8, 0x8e, 0xdd, 0xba, 0x6b, 0x79, 0xe1, 0x81, 0x37, 0xfb, 0xbb, 0xcf,
-	0x77, 0xf7, 0x9d, 0xcf, 0x67, 0xf0, 0x28, 0x29, 0x6e, 0xc7, 0x03, 0xd2, 0xc9, 0x8b, 0x8c, 0x65,
-	0x68, 0x92, 0x90, 0xf8, 0x8f, 0xf3, 0x78, 0x9c, 0x32, 0x52, 0xd0, 0x97, 0x6a, 0x51, 0x5a, 0x7d,
-	0x2f, 0xcb, 0xd9, 0x38, 0x4b, 0xe5, 0x36, 0xd8, 0x02, 0xbb, 0x4f, 0x92, 0x24, 0x8b, 0xc8, 0x04,
-	0x11, 0xaa, 0x69, 0x7c, 0x43, 0x5a, 0x46, 0xdb, 0xd8, 0x75, 0x22, 0xb1, 0x0e, 0xb6, 0xc1, 0x91,
-	0x76, 0x9a, 0x73, 0x02, 0x23, 0x5f, 0x99, 0x22, 0xf0, 0x75, 0x30, 0x01, 0xef, 0x63, 0x11, 0x5f,
-	0x5d, 0x8d, 0x07, 0x27, 0xf1, 0x0d, 0xf7, 0xb2, 0x03, 0xd6, 0x20, 0x4b, 0xb2, 0x42, 0xb0, 0x36,
-	0xc2, 0x66, 0x87, 0x84, 0xa4, 0x23, 0x29, 0xa7, 0xe3, 0xd1, 0x35, 0x8b, 0x4a, 0x3b, 0x1e, 0x80,
-	0xc7, 0x34, 0x98, 0xb6, 0x2a, 0x6d, 0x73, 0xf5, 0x81, 0x45, 0x5e, 0x70, 0x00, 0x1b, 0x7a, 0x48,
-	0x9a, 0xe3, 0x33, 0xa8, 0xa6, 0x2a, 0xb1, 0x95, 0x1e, 0x84, 0x39, 0xf0, 0xc0, 0x3d, 0x97, 0xd5,
-	0x88, 0xc8, 0x24, 0x20, 0x50, 0x9f, 0x6f, 0x69, 0x8e, 0x7b, 0xe0, 0x5e, 0x12, 0xca, 0x24, 0x26,
-	0x9c, 0xb9, 0x61, 0xb3, 0x33, 0x2b, 0xa0, 0x34, 0x44, 0x3a, 0x0b, 0xdb, 0xe0, 0xc6, 0x49, 0xa2,
-	0xfc, 0x08, 0x0d, 0x4e, 0xa4, 0x43, 0xc1, 0x77, 0x43, 0x94, 0x28, 0xa5, 0x49, 0xcc, 0x88, 0x08,
-	0xd4, 0x87, 0x3a, 0x93, 0x00, 0xbf, 0x8a, 0x96, 0xd1, 0x36, 0x77, 0xdd, 0xf0, 0xa9, 0x4a, 0x7b,
-	0xce, 0x9c, 0xed, 0x38, 0xad, 0x97, 0xb2, 0xe2, 0x3e, 0x5a, 0x38, 0xe9, 0x9f, 0x40, 0x73, 0x89,
-	0x82, 0x0d, 0x30, 0xbf, 0x90, 0x7b, 0x79, 0x4b, 0x7c, 0x89, 0xdb, 0x60, 0xdd, 0xc6, 0xc9, 0x94,
-	0xb4, 0x2a, 0x42, 0x93, 0x23, 0x22, 0x5d, 0x64, 0xc5, 0x30, 0x2a, 0xf1, 0xc3, 0xca, 0x6b, 0x23,
-	0xd8, 0x87, 0x2a, 0x87, 0xf8, 0x2d, 0xdf, 0x65, 0xc5, 0x50, 0xdd, 0x32, 0x5f, 0xa3, 0x0f, 0x76,
-	0x12, 0xa7, 0xa3, 0x69, 0x3c, 0x2a, 0x7d, 0x38, 0xd1, 0x6c, 0x1f, 0x7c, 0x33, 0xa0, 0xae, 0x65,
-	0x3d, 0xc1, 0x10, 0x2c, 0x7e, 0x48, 0xe9, 0x7a, 0xf2, 0x50, 0xd7, 0x44, 0x84, 0x96, 0x7a, 0x4a,
-	0xaa, 0x7f, 0x04, 0x30, 0x07, 0xff, 0x55, 0x41, 0x1b, 0xec, 0x6e, 0x41, 0xe2, 0x21, 0x4f, 0x62,
-	0x93, 0xb7, 0xe1, 0x34, 0x2d, 0x7b, 0xc2, 0x8c, 0xca, 0x4d, 0x70, 0x0c, 0x8e, 0x64, 0xd0, 0x1c,
-	0x37, 0xf5, 0x7e, 0xef, 0xaf, 0x95, 0x1d, 0x8f, 0x3e, 0xd4, 0x58, 0x16, 0x53, 0x46, 0x86, 0x22,
-	0x96, 0xdd, 0x5f, 0x8b, 0x14, 0xd0, 0xb5, 0x61, 0x3d, 0x4e, 0xe9, 0x1d, 0x29, 0x82, 0x1f, 0x06,
-	0xb8, 0x47, 0xd7, 0x71, 0x3a, 0x22, 0xef, 0xc8, 0x5f, 0xde, 0x0e, 0x1e, 0x82, 0x9d, 0x17, 0xe4,
-	0x76, 0x9c, 0x4d, 0xcb, 0xbe, 0x70, 0xc3, 0x2d, 0x91, 0xb6, 0x76, 0xae, 0x73, 0x2e, 0x09, 0x65,
-	0x31, 0x66, 0x7c, 0xff, 0x0c, 0xbc, 0x05, 0xd3, 0x8a, 0x92, 0xec, 0x2c, 0x96, 0xa4, 0xf9, 0xc0,
-	0x37, 0xcd, 0xf5, 0xd2, 0xfc, 0x32, 0xa0, 0xae, 0xdb, 0x56, 0x26, 0xec, 0x43, 0x2d, 0x25, 0x77,
-	0x67, 0x1c, 0xae, 0xc8, 0x9a, 0x28, 0x80, 0xdb, 0x06, 0xe2, 0xfc, 0xb0, 0x65, 0xaa, 0xb2, 0x48,
-	0x00, 0xdf, 0x68, 0x42, 0xab, 0x42, 0xe8, 0xf6, 0x52, 0x32, 0xff, 0x4b, 0xe9, 0xfc, 0x8e, 0x5e,
-	0x74, 0x44, 0x5f, 0xce, 0x86, 0x00, 0xd6, 0xc0, 0x8c, 0x7a, 0x6f, 0x1b, 0x6b, 0x08, 0xb0, 0xfe,
-	0xa9, 0x77, 0x7a, 0xfa, 0xfe, 0xa2, 0x61, 0xa0, 0x03, 0xd6, 0x71, 0xd4, 0xeb, 0x9d, 0x35, 0x2a,
-	0xe1, 0xcf, 0x0a, 0xd4, 0x3e, 0x94, 0xa3, 0x14, 0x9f, 0x83, 0x25, 0xe6, 0x1e, 0x7a, 0x22, 0x98,
-	0x9a, 0x91, 0xfe, 0x86, 0xbe, 0xa5, 0x39, 0xbe, 0x02, 0x98, 0xcf, 0x22, 0x44, 0x7d, 0xf2, 0x94,
-	0xf3, 0xd0, 0x7f, 0xb4, 0x84, 0xd1, 0x1c, 0x43, 0x70, 0x8f, 0x89, 0x9a, 0x21, 0x14, 0x1b, 0x82,
-	0xa3, 0xcd, 0x26, 0xbf, 0xf9, 0x00, 0x11, 0x67, 0x9c, 0xd9, 0x23, 0xc2, 0xe6, 0xd2, 0xa3, 0xf2,
-	0x71, 0x79, 0x7e, 0x70, 0x19, 0xa2, 0xdf, 0xa5, 0x0c, 0xf5, 0x3a, 0xa4, 0x8c, 0xf9, 0x53, 0xd8,
-	0x07, 0x5b, 0xd5, 0x53, 0x26, 0xa3, 0x35, 0xa9, 0xbf, 0x5c, 0xf0, 0xc0, 0xfa, 0xdd, 0xad, 0xd8,
-	0x46, 0xd7, 0xfa, 0xcc, 0x7f, 0x9b, 0xcb, 0x75, 0xf1, 0x99, 0xec, 0xfd, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x5e, 0xd6, 0x7a, 0x87, 0x8a, 0x06, 0x00, 0x00,
+	// 1214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x5d, 0x6e, 0xdb, 0x46,
+	0x10, 0x0e, 0xf5, 0x63, 0x91, 0x23, 0xc9, 0xb6, 0x26, 0x06, 0x22, 0xb0, 0x89, 0xa3, 0xb2, 0x49,
+	0x6c, 0xb4, 0xa8, 0x52, 0x28, 0x68, 0xd2, 0x3a, 0x69, 0x81, 0x30, 0x11, 0xec, 0xa4, 0xae, 0x6d,
+	0x30, 0x06, 0xd2, 0xf6, 0xc5, 0xa5, 0xc5, 0xb5, 0x44, 0x98, 0x26, 0x29, 0xee, 0xca, 0xae, 0x73,
+	0x81, 0x1e, 0xa4, 0xe8, 0x53, 0xd1, 0x63, 0xf5, 0x00, 0xf5, 0x09, 0x8a, 0xfd, 0x21, 0xb5, 0x96,
+	0xe4, 0x97, 0x3c, 0xf4, 0x89, 0x3b, 0x33, 0xdf, 0xcc, 0xce, 0x1f, 0x67, 0x07, 0x9a, 0x94, 0x64,
+	0xe7, 0xe1, 0x80, 0x74, 0xd3, 0x2c, 0x61, 0x09, 0x96, 0x49, 0x8f, 0xd8, 0x77, 0x52, 0x3f, 0x8c,
+	0x19, 0xc9, 0xe8, 0xe3, 0xfc, 0x20, 0xa5, 0x76, 0x67, 0x48, 0xe2, 0x61, 0xe6, 0xa7, 0xa3, 0x71,
+	0xf4, 0x38, 0x49, 0x59, 0x98, 0xc4, 0x34, 0xff, 0x4a, 0x84, 0xb3, 0x0e, 0xe6, 0x0e, 0x89, 0xa2,
+	0xc4, 0x23, 0x63, 0x44, 0xa8, 0xc4, 0xfe, 0x19, 0x69, 0x1b, 0x1d, 0x63, 0xd3, 0xf2, 0xc4, 0xd9,
+	0xb9, 0x0f, 0x96, 0x92, 0xd3, 0x94, 0x03, 0x18, 0xf9, 0x8d, 0xe5, 0x00, 0x7e, 0x76, 0xc6, 0xd0,
+	0x3c, 0xcc, 0xfc, 0x93, 0x93, 0x70, 0xf0, 0xd6, 0x3f, 0xe3, 0x56, 0x36, 0xa0, 0x3a, 0x48, 0xa2,
+	0x24, 0x13, 0xa8, 0xe5, 0x5e, 0xab, 0x4b, 0x7a, 0xa4, 0xab, 0x20, 0xbb, 0xe1, 0x70, 0xc4, 0x3c,
+	0x29, 0xc7, 0x67, 0xd0, 0x64, 0x1a, 0x9b, 0xb6, 0x4b, 0x9d, 0xf2, 0x62, 0x85, 0xeb, 0x38, 0xe7,
+	0x19, 0x2c, 0xeb, 0x57, 0xd2, 0x14, 0x1f, 0x42, 0x25, 0xce, 0x1d, 0x5b, 0x68, 0x41, 0x88, 0x9d,
+	0x26, 0xd4, 0x0f, 0x54, 0x82, 0x3c, 0x32, 0x76, 0x08, 0x34, 0xa6, 0x24, 0x4d, 0xf1, 0x09, 0xd4,
+	0x8f, 0x09, 0x65, 0x8a, 0x27, 0x8c, 0xd5, 0x7b, 0xad, 0x6e, 0x91, 0x53, 0x25, 0xf0, 0x74, 0x14,
+	0x76, 0xa0, 0xee, 0x47, 0x51, 0x6e, 0x47, 0xc4, 0x60, 0x79, 0x3a, 0xcb, 0xf9, 0xd3, 0x10, 0x29,
+	0x8a, 0x69, 0xe4, 0x33, 0x22, 0x2e, 0xda, 0x81, 0x06, 0x53, 0x0c, 0x5e, 0x8a, 0xb6, 0xd1, 0x29,
+	0x6f, 0xd6, 0x7b, 0x0f, 0x72, 0xb7, 0xa7, 0xc8, 0xee, 0xa1, 0x06, 0xeb, 0xc7, 0x2c, 0xbb, 0xf4,
+	0xae, 0x69, 0xda, 0x6f, 0xa1, 0x35, 0x07, 0xc1, 0x55, 0x28, 0x9f, 0x92, 0x4b, 0x55, 0x25, 0x7e,
+	0xc4, 0xfb, 0x50, 0x3d, 0xf7, 0xa3, 0x09, 0x69, 0x97, 0x44, 0x4c, 0x96, 0xb8, 0xe9, 0x7d, 0x92,
+	0x05, 0x9e, 0xe4, 0x6f, 0x95, 0xbe, 0x31, 0x9c, 0xa7, 0x50, 0xe1, 0x2c, 0x5e, 0xe5, 0x8b, 0x24,
+	0x0b, 0xf2, 0x2a, 0xf3, 0x33, 0xda, 0x60, 0x46, 0x7e, 0x3c, 0x9c, 0xf8, 0x43, 0x69, 0xc3, 0xf2,
+	0x0a, 0xda, 0xf9, 0xdd, 0x80, 0x86, 0xe6, 0xf5, 0x18, 0x7b, 0x50, 0xe5, 0x4a, 0x79, 0x5c, 0x77,
+	0x67, 0xe3, 0x1a, 0x8b, 0xab, 0x55, 0x3c, 0x12, 0x6a, 0xbf, 0x02, 0x98, 0x32, 0x3f, 0x36, 0x82,
+	0x0e, 0x98, 0x6e, 0x46, 0xfc, 0x80, 0x3b, 0xb1, 0xc6, 0xdb, 0x70, 0x12, 0xcb, 0x9e, 0x28, 0x7b,
+	0x92, 0x70, 0xb6, 0xc1, 0x52, 0x08, 0x9a, 0xe2, 0x9a, 0xde, 0xef, 0x3b, 0xb7, 0x64, 0xc7, 0xa3,
+	0x0d, 0x35, 0x96, 0xf8, 0x94, 0x91, 0x40, 0xdc, 0x65, 0xee, 0xdc, 0xf2, 0x72, 0x86, 0x6b, 0xc2,
+	0x92, 0x1f, 0xd3, 0x0b, 0x92, 0x39, 0x7f, 0x1b, 0x50, 0x7f, 0x35, 0xf2, 0xe3, 0x21, 0xf9, 0x91,
+	0xdc, 0xf0, 0xef, 0xe0, 0x16, 0x98, 0x69, 0x46, 0xce, 0xc3, 0x64, 0x22, 0xfb, 0xa2, 0xde, 0x5b,
+	0x17, 0x6e, 0x6b, 0x7a, 0xdd, 0x03, 0x05, 0x90, 0xc9, 0x28, 0xf0, 0xf6, 0x1e, 0x34, 0xaf, 0x89,
+	0x16, 0xa4, 0x64, 0xe3, 0x7a, 0x4a, 0x5a, 0x33, 0xb6, 0x69, 0xaa, 0xa7, 0xe6, 0x5f, 0x03, 0x1a,
+	0xba, 0x6c, 0xa1, 0xc3, 0x36, 0xd4, 0x62, 0x72, 0xb1, 0xe7, 0x9f, 0x49, 0x9b, 0x3c, 0x27, 0x39,
+	0x83, 0xcb, 0x06, 0x42, 0x3f, 0x68, 0x97, 0xf3, 0xb4, 0x28, 0x06, 0x3e, 0xd7, 0x02, 0xad, 0x88,
+	0x40, 0xef, 0xcf, 0x39, 0xf3, 0x7f, 0x45, 0xaa, 0xd5, 0xe8, 0x57, 0x68, 0xec, 0x86, 0x94, 0xb9,
+	0x49, 0x72, 0x4a, 0x55, 0x4b, 0xd0, 0x11, 0x89, 0x4e, 0x94, 0x69, 0x49, 0xe0, 0x27, 0x60, 0xa5,
+	0xfe, 0x90, 0x1c, 0xd1, 0xf0, 0x83, 0xbc, 0xa0, 0xea, 0x99, 0x9c, 0xf1, 0x2e, 0xfc, 0x40, 0xf0,
+	0x1e, 0x80, 0x10, 0xb2, 0xe4, 0x94, 0xc4, 0x22, 0x70, 0xcb, 0x13, 0xf0, 0x43, 0xce, 0x70, 0x7e,
+	0x82, 0xa6, 0x76, 0x03, 0x4d, 0x79, 0x9b, 0x1e, 0x73, 0x42, 0xb5, 0xbe, 0x6c, 0x53, 0x2e, 0xf6,
+	0x24, 0x1f, 0x1f, 0xc1, 0x0a, 0x1f, 0x45, 0x47, 0x9a, 0x55, 0xf9, 0x3f, 0x35, 0x39, 0xfb, 0xa0,
+	0xb0, 0xfc, 0x87, 0x01, 0x15, 0xae, 0xc7, 0x9d, 0x66, 0x21, 0x8b, 0xf2, 0x42, 0x49, 0x02, 0xbf,
+	0x07, 0xcb, 0x9f, 0xb0, 0x51, 0x92, 0x1d, 0x85, 0xb2, 0x4d, 0x2d, 0xf7, 0xd3, 0x2b, 0x77, 0x1d,
+	0xee, 0x82, 0xb5, 0x4d, 0xd8, 0x4b, 0x21, 0xc2, 0x15, 0x28, 0x85, 0x01, 0x4e, 0x81, 0x9e, 0x29,
+	0x8f, 0x6f, 0x02, 0xec, 0x83, 0x45, 0x82, 0x90, 0x49, 0x7d, 0x11, 0x96, 0xbb, 0x79, 0xe5, 0x3e,
+	0x84, 0xcf, 0x60, 0xc5, 0xf5, 0xd9, 0x60, 0x54, 0x18, 0xa1, 0xbc, 0x1c, 0x61, 0x40, 0x71, 0x8a,
+	0xf7, 0x4c, 0x79, 0x7c, 0x13, 0x38, 0x8f, 0x00, 0x67, 0xe0, 0x3c, 0xcf, 0x52, 0x43, 0xa4, 0xc0,
+	0xf2, 0xf8, 0xd1, 0x79, 0x01, 0xb7, 0xe7, 0x70, 0x62, 0x6c, 0xd7, 0xa4, 0x47, 0x79, 0xbe, 0xea,
+	0x22, 0x5f, 0x12, 0xe2, 0xe5, 0x32, 0x67, 0x1d, 0x1a, 0x85, 0x22, 0xb7, 0xbf, 0xcc, 0xe3, 0x52,
+	0xf9, 0x28, 0x85, 0x81, 0xe3, 0xc2, 0x92, 0x8a, 0x78, 0x46, 0x52, 0x34, 0x79, 0x69, 0xda, 0xe4,
+	0x5b, 0x6b, 0x57, 0x6e, 0x0b, 0x54, 0x6a, 0x0a, 0xb3, 0xce, 0x5f, 0x25, 0x30, 0xb7, 0x33, 0x42,
+	0xd8, 0x4d, 0x3f, 0xb3, 0xa8, 0xc3, 0x19, 0xa1, 0xaa, 0x45, 0x24, 0xc1, 0x91, 0xcc, 0x1f, 0xd2,
+	0x76, 0x59, 0xc4, 0x2a, 0xce, 0xbc, 0x5b, 0x23, 0xfe, 0xe8, 0xb4, 0x2b, 0x37, 0x3e, 0x80, 0x42,
+	0x8e, 0xf7, 0xd4, 0xa0, 0xad, 0xce, 0x8e, 0x34, 0xc1, 0xc6, 0xef, 0x00, 0x82, 0x70, 0xc0, 0xe7,
+	0xba, 0x9f, 0x5d, 0xb6, 0x97, 0x44, 0x82, 0xee, 0x09, 0x50, 0xee, 0x68, 0xf7, 0x75, 0x21, 0x97,
+	0x7f, 0x95, 0xa6, 0xc0, 0xab, 0x90, 0x91, 0x71, 0xbb, 0x26, 0x7f, 0xa3, 0x8c, 0x8c, 0xed, 0x1d,
+	0x58, 0x99, 0x51, 0xf8, 0xd8, 0x41, 0xbb, 0x05, 0xa6, 0x78, 0xde, 0x78, 0xb2, 0xda, 0x50, 0x4b,
+	0xb5, 0x17, 0xd3, 0xf2, 0x72, 0x12, 0xd7, 0xf2, 0x4d, 0x40, 0xa6, 0x5f, 0x12, 0xce, 0x06, 0x58,
+	0x4a, 0x97, 0xa6, 0xfc, 0x5d, 0x11, 0xe8, 0x30, 0x1e, 0x2a, 0xed, 0x82, 0x76, 0x5e, 0x00, 0xec,
+	0x4f, 0xd8, 0xfe, 0x89, 0x40, 0xf3, 0x6b, 0xce, 0x08, 0xa5, 0xfc, 0x01, 0x52, 0xd7, 0x28, 0x72,
+	0xf1, 0x35, 0x9f, 0x77, 0xa1, 0xa1, 0xe7, 0x1c, 0x6b, 0x50, 0xf6, 0xfa, 0xaf, 0x57, 0x6f, 0x21,
+	0xc0, 0xd2, 0xcf, 0xfd, 0xdd, 0xdd, 0xfd, 0xf7, 0xab, 0x06, 0x5a, 0x50, 0xdd, 0xf6, 0xfa, 0xfd,
+	0xbd, 0xd5, 0x52, 0xef, 0x9f, 0x0a, 0xd4, 0xde, 0xc9, 0xd5, 0x0a, 0x1f, 0x41, 0x55, 0x2c, 0x3d,
+	0xd8, 0x14, 0xd1, 0xe7, 0x0b, 0x92, 0xbd, 0xac, 0x93, 0x34, 0xc5, 0xaf, 0x01, 0xa6, 0x8b, 0x08,
+	0xa2, 0x5e, 0x68, 0xb9, 0x0c, 0xd9, 0xb7, 0xe7, 0x78, 0x34, 0xc5, 0x1e, 0xd4, 0xb7, 0x49, 0xbe,
+	0x40, 0x50, 0x5c, 0x15, 0x18, 0x6d, 0x31, 0xb1, 0x5b, 0x33, 0x1c, 0xa1, 0x63, 0x15, 0x2f, 0x28,
+	0xb6, 0xe6, 0x5e, 0x54, 0x1b, 0xe7, 0x97, 0x07, 0x1e, 0x86, 0x78, 0xec, 0x54, 0x18, 0xf9, 0xd3,
+	0x68, 0x2f, 0xeb, 0x24, 0x4d, 0xf1, 0x29, 0x98, 0xf9, 0x30, 0x55, 0xce, 0x68, 0x2f, 0x94, 0x3d,
+	0x3f, 0x6d, 0x9d, 0xea, 0x95, 0x5b, 0x32, 0x0d, 0xfc, 0x16, 0xac, 0x62, 0xfa, 0x29, 0x9f, 0xf4,
+	0x79, 0x6b, 0xe3, 0x2c, 0x4b, 0xa9, 0x76, 0x0c, 0xfc, 0x42, 0x9f, 0x53, 0x52, 0x55, 0xff, 0xc5,
+	0x6d, 0x7d, 0x10, 0xe0, 0x0f, 0xf3, 0x43, 0xe9, 0x8e, 0x0c, 0x61, 0x6e, 0xf6, 0xd8, 0xed, 0xc5,
+	0x02, 0x75, 0xf3, 0x57, 0x06, 0x7e, 0x09, 0x55, 0xf1, 0xfb, 0xa8, 0xa4, 0xe4, 0xbf, 0xd2, 0x6c,
+	0x6d, 0x05, 0x7c, 0xd3, 0xc0, 0x7d, 0xa8, 0xca, 0xfe, 0x6b, 0x4e, 0x6b, 0x32, 0x85, 0x17, 0x8d,
+	0xec, 0x6c, 0x5c, 0xb9, 0x0f, 0x4c, 0xc3, 0xd6, 0x3a, 0xd6, 0x5e, 0x2b, 0x16, 0xc7, 0xbd, 0x84,
+	0xbd, 0x54, 0xd5, 0x74, 0x1b, 0xbf, 0x54, 0xbb, 0xcf, 0x49, 0x8f, 0x88, 0x14, 0x1e, 0x2f, 0x89,
+	0x2d, 0xfc, 0xc9, 0x7f, 0x03, 0x00, 0xd8, 0xac, 0x6a, 0xa7, 0xd6, 0x0b, 0x00, 0x00,
 }
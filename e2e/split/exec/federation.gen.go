@@ -0,0 +1,101 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/tmc/protoc-gen-graphql/e2e"
+	"github.com/tmc/protoc-gen-graphql/e2e/split/model"
+)
+
+// sdl is the schema of the subgraph.
+const sdl = "extend schema @link(url: \"https://specs.apollo.dev/federation/v2.0\", import: [\"@key\", \"@shareable\", \"@external\", \"FieldSet\"])\n\nextend type Query {\n\thello(req: HelloReq): HelloResp!\n\ttrafficJam(req: TrafficJamReq): TrafficJamResp!\n\tgetPainters: PaintersResp!\n\ttranslate(req: TranslateReq): TranslateResp!\n\tbread(req: BreadReq): BreadResp!\n\tlistBooks(req: ListBooksReqConnectionInput, first: Int, after: String): BookConnection!\n\tgetAuthor(req: GetAuthorReq): Author!\n\tbatchGetAuthors(req: BatchGetAuthorsReq): BatchGetAuthorsResp!\n\tgreet(name: String, times: Int, tags: [String], light: TrafficLight, word: Word, dictionary: Dictionary, req: String): HelloResp!\n}\n\nextend type Mutation {\n\tchangeMe(req: ChangeMeReq): ChangeMeResp!\n\tpaint(req: PaintReq): PaintResult!\n}\n\n\"\"\"\nError is implemented by the error types that RPCs respond with instead of failing.\n\"\"\"\ninterface Error {\n\tmessage: String!\n\n}\n\ntype Author @key(fields: \"id\") {\n\tid: String!\n\n\tname: String!\n\n}\n\ntype BatchGetAuthorsResp {\n\tauthors: [Author]!\n\n}\n\ntype Book {\n\ttitle: String!\n\n\tauthor_id: String!\n\n\teditor_id: String!\n\n\tauthor: Author\n\n\teditor: Author\n\n}\n\n\"\"\"\nBookConnection is a Relay connection of Book.\n\"\"\"\ntype BookConnection {\n\tedges: [BookEdge]!\n\n\tpageInfo: PageInfo!\n\n}\n\n\"\"\"\nBookEdge is an edge in a connection of Book.\n\"\"\"\ntype BookEdge {\n\t\"\"\"\n\tcursor resumes the connection right after this edge.\n\t\"\"\"\n\tcursor: String!\n\n\tnode: Book!\n\n}\n\ntype BreadResp {\n\tanswer: BreadRespAnswer!\n\n}\n\ntype BreadRespAnswerName {\n\tname: String!\n\n}\n\ntype BreadRespAnswerToasted {\n\ttoasted: Boolean!\n\n}\n\ntype ChangeMeResp {\n\tname: String!\n\n\tprevious: Previous!\n\n\tanswer: ChangeMeRespAnswer!\n\n}\n\ntype ChangeMeRespAnswerChanged {\n\tchanged: Boolean!\n\n}\n\ntype ChangeMeRespAnswerNewName {\n\tnewName: String!\n\n}\n\ntype HelloResp {\n\ttext: String!\n\n}\n\ntype OutOfPaint implements Error {\n\tmessage: String!\n\n\tcolor: String!\n\n}\n\n\"\"\"\nPageInfo describes the page of a Relay connection.\n\"\"\"\ntype PageInfo {\n\thasNextPage: Boolean!\n\n\thasPreviousPage: Boolean!\n\n\tstartCursor: String\n\n\tendCursor: String\n\n}\n\ntype PaintResp {\n\tpainting: String!\n\n}\n\ntype PaintersResp {\n\tbestPainter: Painters_Painter!\n\n\tallPainters: [String]!\n\n}\n\ntype Painters_NotAPainter implements Error {\n\tmessage: String!\n\n\tname: String!\n\n}\n\ntype Painters_Painter {\n\tname: String!\n\n}\n\ntype TrafficJamResp {\n\tnext: TrafficLight!\n\n}\n\ntype TranslateResp {\n\ttranslations: Translations!\n\n}\n\ninput BatchGetAuthorsReq {\n\tids: [String]\n}\n\ninput BreadReq {\n\tcount: Int\n}\n\ninput ChangeMeReq {\n\tname: String\n\tprevious: Previous\n}\n\ninput GetAuthorReq {\n\tid: String\n}\n\ninput HelloReq {\n\tname: String\n}\n\ninput ListBooksReqConnectionInput {\n\tshelf: String\n}\n\ninput PaintReq {\n\tpainter: String\n\tcolor: String\n}\n\ninput TrafficJamReq {\n\tcolor: TrafficLight\n\ttrafficLights: [TrafficLight]\n}\n\ninput TranslateReq {\n\twords: Words\n}\n\ninput Word {\n\tword: String\n\tlanguage: String\n}\n\nenum TrafficLight {\n\tRED\n\tYELLOW\n\tGREEN\n}\n\nscalar Dictionary\n\nscalar Previous\n\nscalar Translations\n\nscalar Words\n\nunion BreadRespAnswer = BreadRespAnswerName | BreadRespAnswerToasted\nunion ChangeMeRespAnswer = ChangeMeRespAnswerChanged | ChangeMeRespAnswerNewName\nunion PaintResult = OutOfPaint | PaintResp | Painters_NotAPainter\n"
+
+func (ec *executionContext) __resolve__service(ctx context.Context) (fedruntime.Service, error) {
+	return fedruntime.Service{SDL: sdl}, nil
+}
+
+// service returns the service of the executor's resolvers.
+func (ec *executionContext) service() (e2e.Service, error) {
+	service, ok := ec.resolvers.(e2e.Service)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resolvers %T", ec.resolvers)
+	}
+	return service, nil
+}
+
+func (ec *executionContext) __resolve_entities(ctx context.Context, representations []map[string]interface{}) ([]model.UnionMask, error) {
+	service, err := ec.service()
+	if err != nil {
+		return nil, err
+	}
+	return resolveEntities(ctx, service, representations)
+}
+
+// resolveEntities looks up each representation of the _entities
+// field, in the order of the representations. A representation that
+// cannot be looked up is null, with an error at its index, so that
+// the other entities of the router's batch are still returned.
+func resolveEntities(ctx context.Context, service e2e.Service, representations []map[string]interface{}) ([]model.UnionMask, error) {
+	entities := make([]model.UnionMask, len(representations))
+	for i, rep := range representations {
+		entity, err := resolveEntity(ctx, service, rep)
+		if err != nil {
+			index := i
+			graphql.AddError(graphql.WithFieldContext(ctx, &graphql.FieldContext{Index: &index}), err)
+			continue
+		}
+		entities[i] = entity
+	}
+	return entities, nil
+}
+
+// resolveEntity looks up a representation
+// with the lookup RPC of its __typename.
+func resolveEntity(ctx context.Context, service e2e.Service, rep map[string]interface{}) (model.UnionMask, error) {
+	typeName, _ := rep["__typename"].(string)
+	switch typeName {
+	case "Author":
+		req := &e2e.GetAuthorReq{}
+		err := unmarshalRepresentation(rep, map[string]string{
+			"id": "id",
+		}, req)
+		if err != nil {
+			return nil, fmt.Errorf("Author representation: %v", err)
+		}
+		entity, err := service.GetAuthor(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return entity, nil
+	default:
+		return nil, fmt.Errorf("unknown entity type %q", typeName)
+	}
+}
+
+// unmarshalRepresentation sets each request field of
+// fields to the value of its key field in the representation.
+func unmarshalRepresentation(rep map[string]interface{}, fields map[string]string, req proto.Message) error {
+	values := map[string]interface{}{}
+	for field, key := range fields {
+		v, ok := rep[key]
+		if !ok {
+			return fmt.Errorf("missing key field %v", key)
+		}
+		values[field] = v
+	}
+	bts, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(bts), req)
+}
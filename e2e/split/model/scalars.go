@@ -0,0 +1,66 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/tmc/protoc-gen-graphql/e2e"
+)
+
+type Dictionary map[string]*e2e.Word
+
+func (scalar *Dictionary) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal([]byte(str), scalar)
+}
+
+func (scalar Dictionary) MarshalGQL(w io.Writer) {
+	json.NewEncoder(w).Encode(scalar)
+}
+
+type Previous map[string]*e2e.ChangeMeResp
+
+func (scalar *Previous) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal([]byte(str), scalar)
+}
+
+func (scalar Previous) MarshalGQL(w io.Writer) {
+	json.NewEncoder(w).Encode(scalar)
+}
+
+type Translations map[string]*e2e.Word
+
+func (scalar *Translations) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal([]byte(str), scalar)
+}
+
+func (scalar Translations) MarshalGQL(w io.Writer) {
+	json.NewEncoder(w).Encode(scalar)
+}
+
+type Words map[string]*e2e.Word
+
+func (scalar *Words) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal([]byte(str), scalar)
+}
+
+func (scalar Words) MarshalGQL(w io.Writer) {
+	json.NewEncoder(w).Encode(scalar)
+}
@@ -0,0 +1,43 @@
+// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package resolver
+
+import (
+	"errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// DataError is an error of a service that carries one of the
+// error types that an RPC responds_with. Its resolver returns
+// the error type as data, as a member of the RPC's result union,
+// instead of failing with the error. For example:
+//
+//	return nil, resolver.NewDataError(&pb.NotFound{Message: "no such book"})
+type DataError struct {
+	Message proto.Message
+}
+
+// NewDataError returns a DataError that carries msg.
+func NewDataError(msg proto.Message) error {
+	return &DataError{Message: msg}
+}
+
+func (e *DataError) Error() string {
+	if m, ok := e.Message.(interface{ GetMessage() string }); ok {
+		return m.GetMessage()
+	}
+	return string(proto.MessageName(e.Message))
+}
+
+// dataErrors returns the messages that err carries, which are
+// either the message of a DataError.
+func dataErrors(err error) []proto.Message {
+	if err == nil {
+		return nil
+	}
+	var dataErr *DataError
+	if errors.As(err, &dataErr) {
+		return []proto.Message{dataErr.Message}
+	}
+	return nil
+}
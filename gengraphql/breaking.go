@@ -0,0 +1,26 @@
+package gengraphql
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/tmc/protoc-gen-graphql/internal/breaking"
+)
+
+// checkBreaking fails the generation when the schema, extended
+// by the extra_schema files, breaks the clients of the schema in
+// the given file, which is read before schema.graphql gets
+// written so that it may be the same file.
+// A file that does not exist yet, as on the first run, has no
+// clients to break.
+func (tql *gengraphql) checkBreaking(filename string) {
+	old, err := breaking.Load(filename)
+	if os.IsNotExist(err) {
+		return
+	}
+	must(err)
+	if changes := breaking.Compare(old, tql.servedSchema()); len(changes) > 0 {
+		panic(fmt.Sprintf("breaking changes against %v:\n\t%v", filename, strings.Join(changes, "\n\t")))
+	}
+}
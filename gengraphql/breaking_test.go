@@ -0,0 +1,30 @@
+package gengraphql
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreaking(t *testing.T) {
+	m, f := getModule(t, "simple")
+	m.generateSchema(f, ioutil.Discard)
+	msg := catch(func() { m.checkBreaking(filepath.Join("testdata", "simple", "breaking", "schema.graphql")) })
+	if *update {
+		writeGolden(t, []byte(msg), filepath.Join("simple", "breaking"), "breaking.golden")
+		return
+	}
+	require.Equal(t, readGolden(t, filepath.Join("simple", "breaking"), "breaking.golden"), msg)
+}
+
+func TestBreakingAgainstItself(t *testing.T) {
+	m, f := getModule(t, "simple")
+	m.generateSchema(f, ioutil.Discard)
+	msg := catch(func() { m.checkBreaking(filepath.Join("testdata", "simple", "schema.graphql.golden")) })
+	require.Equal(t, "<nil>", msg)
+	// On the first run, there is no schema to compare with yet.
+	msg = catch(func() { m.checkBreaking(filepath.Join(t.TempDir(), "schema.graphql")) })
+	require.Equal(t, "<nil>", msg)
+}
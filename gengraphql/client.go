@@ -0,0 +1,144 @@
+package gengraphql
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/tmc/protoc-gen-graphql/internal/genclient"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// writeClient renders the Go client of the schema into the
+// client sub-package, with a method for each Query and Mutation
+// field that returns an RPC's response. Connections are left out
+// since their fields do not return the response message.
+func (tql *gengraphql) writeClient() {
+	data := &genclient.Data{
+		PackageName: "client",
+		ServiceName: tql.svcname,
+	}
+	for _, pm := range tql.svc.Methods() {
+		if tql.isSkipped(pm) {
+			continue
+		}
+		if conn, _ := tql.getConnection(pm); conn != nil {
+			continue
+		}
+		data.Methods = append(data.Methods, tql.getClientMethod(pm))
+	}
+	must(os.MkdirAll(tql.path("client"), 0755))
+	f, err := os.Create(filepath.Join(tql.path("client"), "client.gen.go"))
+	must(err)
+	defer f.Close()
+	must(genclient.Render(data, f))
+}
+
+func (tql *gengraphql) getClientMethod(pm pgs.Method) *genclient.Method {
+	m := &genclient.Method{
+		Name:               pm.Name().UpperCamelCase().String(),
+		Field:              pm.Name().LowerCamelCase().String(),
+		RequestImportPath:  tql.deduceImportPath(pm.Input()),
+		RequestPkg:         tql.ctx.PackageName(pm.Input()).String(),
+		RequestType:        tql.ctx.Name(pm.Input()).String(),
+		ResponseImportPath: tql.deduceImportPath(pm.Output()),
+		ResponsePkg:        tql.ctx.PackageName(pm.Output()).String(),
+		ResponseType:       tql.ctx.Name(pm.Output()).String(),
+	}
+	var field *ast.FieldDefinition
+	m.Operation, field = tql.rootField(pm)
+	vars, args := fieldArguments(field)
+	if args != "" {
+		m.Flattened = tql.isFlattened(pm)
+		m.Input = !m.Flattened
+	}
+	m.Document = fmt.Sprintf("%v %v%v { %v%v %%s }", m.Operation, m.Name, vars, m.Field, args)
+	if !tql.hasResponseCombination(pm) {
+		m.Selection = tql.selectMessage(pm.Output(), map[string]bool{})
+		return m
+	}
+	// The result union asks for the fields
+	// of each of its member types.
+	responseName, _ := tql.getQualifiedName(pm.Output())
+	fragments := []string{"__typename", "... on " + responseName + " " + tql.selectMessage(pm.Output(), map[string]bool{})}
+	for _, name := range getModifiers(pm).GetRespondsWith() {
+		msg := tql.lookupMessage(pm.File(), name)
+		typeName, _ := tql.getQualifiedName(msg)
+		fragments = append(fragments, "... on "+typeName+" "+tql.selectMessage(msg, map[string]bool{}))
+		m.Errors = append(m.Errors, &genclient.ErrorType{
+			Name:       typeName,
+			ImportPath: tql.deduceImportPath(msg),
+			Pkg:        tql.ctx.PackageName(msg).String(),
+			Type:       tql.ctx.Name(msg).String(),
+		})
+	}
+	m.Selection = "{ " + strings.Join(fragments, " ") + " }"
+	return m
+}
+
+// selectMessage returns the selection set of every field of
+// a message that its type has, apart from the fields resolved
+// by other RPCs. A field of a message that is already being
+// selected is left out so that recursive messages terminate.
+func (tql *gengraphql) selectMessage(msg pgs.Message, path map[string]bool) string {
+	name, _ := tql.getQualifiedName(msg)
+	def := tql.schema.Types[name]
+	path[name] = true
+	defer delete(path, name)
+	selections := []string{}
+	for _, pf := range msg.NonOneOfFields() {
+		fieldName := pf.Name().String()
+		if def.Fields.ForName(fieldName) == nil || tql.fieldResolvers[name+"."+fieldName] != nil {
+			continue
+		}
+		if sel, ok := tql.selectField(pf, path); ok {
+			selections = append(selections, fieldName+sel)
+		}
+	}
+	for _, oo := range msg.OneOfs() {
+		if def.Fields.ForName(oo.Name().String()) == nil {
+			continue
+		}
+		// Each member type of the oneof's
+		// union holds one of its fields.
+		fragments := []string{"__typename"}
+		for _, pf := range oo.Fields() {
+			if sel, ok := tql.selectField(pf, path); ok {
+				fragments = append(fragments, fmt.Sprintf("... on %v { %v%v }",
+					tql.getUnionFieldWrapperName(pf), pf.Name(), sel))
+			}
+		}
+		selections = append(selections, oo.Name().String()+" { "+strings.Join(fragments, " ")+" }")
+	}
+	if len(selections) == 0 {
+		selections = append(selections, "__typename")
+	}
+	return "{ " + strings.Join(selections, " ") + " }"
+}
+
+// selectField returns the selection set of a field, which is empty
+// for scalars, enums and maps. It reports false when the field's
+// message is already being selected.
+func (tql *gengraphql) selectField(pf pgs.Field, path map[string]bool) (string, bool) {
+	var msg pgs.Message
+	switch {
+	case pf.Type().IsMap():
+		return "", true
+	case pf.Type().IsEmbed():
+		msg = pf.Type().Embed()
+	case pf.Type().IsRepeated() && pf.Type().Element().IsEmbed():
+		msg = pf.Type().Element().Embed()
+	default:
+		return "", true
+	}
+	name, _ := tql.getQualifiedName(msg)
+	if def := tql.schema.Types[name]; def == nil || def.Kind == ast.Scalar {
+		return "", true
+	}
+	if path[name] {
+		return "", false
+	}
+	return " " + tql.selectMessage(msg, path), true
+}
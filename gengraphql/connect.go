@@ -0,0 +1,53 @@
+package gengraphql
+
+import (
+	"os"
+
+	"github.com/tmc/protoc-gen-graphql/internal/genconnect"
+)
+
+// connectPackage is the import path and package name of
+// the connect client, which protoc-gen-connect-go generates
+// in a sub-package named after the Go package of the service.
+func (tql *gengraphql) connectPackage() (string, string) {
+	pkg := tql.gopkgname + "connect"
+	return tql.modname + "/" + pkg, pkg
+}
+
+// servicePackage is the import path and package name of the
+// service type that the resolvers call. It is empty for the
+// connect backend, whose connectService is generated in place.
+func (tql *gengraphql) servicePackage() (string, string) {
+	if tql.backend == "connect" {
+		return "", ""
+	}
+	return tql.modname, tql.gopkgname
+}
+
+func (tql *gengraphql) writeConnect() {
+	f, err := os.Create(tql.pkgPath(tql.resolverDir, "connect.gen.go"))
+	must(err)
+	defer f.Close()
+	importPath, pkg := tql.connectPackage()
+	data := &genconnect.Data{
+		PackageName:      tql.pkgName(tql.resolverDir),
+		ClientImportPath: importPath,
+		ClientPkg:        pkg,
+		ServiceName:      tql.svcname,
+	}
+	for _, pm := range tql.svc.Methods() {
+		if pm.ClientStreaming() || pm.ServerStreaming() {
+			continue
+		}
+		data.Methods = append(data.Methods, &genconnect.Method{
+			Name:               pm.Name().UpperCamelCase().String(),
+			RequestImportPath:  tql.deduceImportPath(pm.Input()),
+			RequestPkg:         tql.ctx.PackageName(pm.Input()).String(),
+			RequestType:        tql.ctx.Name(pm.Input()).String(),
+			ResponseImportPath: tql.deduceImportPath(pm.Output()),
+			ResponsePkg:        tql.ctx.PackageName(pm.Output()).String(),
+			ResponseType:       tql.ctx.Name(pm.Output()).String(),
+		})
+	}
+	must(genconnect.Render(data, f))
+}
@@ -0,0 +1,167 @@
+package gengraphql
+
+import (
+	"fmt"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/tmc/protoc-gen-graphql/internal/genresolver"
+)
+
+// getConnection returns how an RPC maps onto a Relay connection
+// if it was marked with (gengraphql.options.rpc).connection, or if
+// the schema sets relay and the RPC follows AIP-158 pagination.
+// It returns nil for every other RPC, otherwise it also returns the
+// message type of the connection's nodes.
+func (tql *gengraphql) getConnection(pm pgs.Method) (*genresolver.Connection, pgs.Message) {
+	explicit := getModifiers(pm).GetConnection()
+	if !explicit && !getSchemaOptions(pm.File()).GetRelay() {
+		return nil, nil
+	}
+	pageSize := getFieldByName(pm.Input(), "page_size")
+	pageToken := getFieldByName(pm.Input(), "page_token")
+	nextPageToken := getFieldByName(pm.Output(), "next_page_token")
+	var items pgs.Field
+	for _, f := range pm.Output().NonOneOfFields() {
+		if !f.Type().IsRepeated() || !f.Type().Element().IsEmbed() {
+			continue
+		}
+		if items != nil {
+			items = nil
+			break
+		}
+		items = f
+	}
+	if pageSize == nil || pageToken == nil || nextPageToken == nil || items == nil {
+		if explicit {
+			panic(fmt.Sprintf(
+				"%v: connection RPCs must have page_size and page_token request fields "+
+					"and a next_page_token and a single repeated message response field",
+				pm.FullyQualifiedName(),
+			))
+		}
+		return nil, nil
+	}
+	node := items.Type().Element().Embed()
+	nodeName, _ := tql.getQualifiedName(node)
+	modelImportPath, _ := tql.packagePaths(tql.modelDir, tql.resolverDir)
+	return &genresolver.Connection{
+		RequestImportPath: tql.deduceImportPath(pm.Input()),
+		RequestType:       tql.ctx.Name(pm.Input()).String(),
+		PageSize:          tql.ctx.Name(pageSize).String(),
+		PageSizeType:      tql.ctx.Type(pageSize).String(),
+		PageToken:         tql.ctx.Name(pageToken).String(),
+		NextPageToken:     tql.ctx.Name(nextPageToken).String(),
+		Items:             tql.ctx.Name(items).String(),
+		ConnectionType:    nodeName + "Connection",
+		EdgeType:          nodeName + "Edge",
+		ModelImportPath:   modelImportPath,
+	}, node
+}
+
+// setConnection declares the connection, edge and page info types
+// of a connection RPC and returns its query arguments, in which
+// page_size and page_token are replaced by Relay's first and after.
+func (tql *gengraphql) setConnection(pm pgs.Method, conn *genresolver.Connection, node pgs.Message) string {
+	tql.connections[pm.Name().UpperCamelCase().String()] = conn
+	tql.setType(node)
+	nodeName, _ := tql.getQualifiedName(node)
+	tql.setConnectionType(pm, &serviceType{
+		Name: conn.EdgeType,
+		Doc:  fmt.Sprintf("%v is an edge in a connection of %v.", conn.EdgeType, nodeName),
+		Fields: []*serviceField{
+			{Name: "cursor", Type: "String", Doc: "cursor resumes the connection right after this edge."},
+			{Name: "node", Type: nodeName},
+		},
+	})
+	tql.setConnectionType(pm, &serviceType{
+		Name: conn.ConnectionType,
+		Doc:  fmt.Sprintf("%v is a Relay connection of %v.", conn.ConnectionType, nodeName),
+		Fields: []*serviceField{
+			{Name: "edges", Type: "[" + conn.EdgeType + "]"},
+			{Name: "pageInfo", Type: "PageInfo"},
+		},
+	})
+	tql.setConnectionType(pm, &serviceType{
+		Name: "PageInfo",
+		Doc:  "PageInfo describes the page of a Relay connection.",
+		Fields: []*serviceField{
+			{Name: "hasNextPage", Type: "Boolean"},
+			{Name: "hasPreviousPage", Type: "Boolean"},
+			{Name: "startCursor", Type: "String", Optional: true},
+			{Name: "endCursor", Type: "String", Optional: true},
+		},
+	})
+	fields := []pgs.Field{}
+	for _, f := range pm.Input().NonOneOfFields() {
+		if f.Name() != "page_size" && f.Name() != "page_token" {
+			fields = append(fields, f)
+		}
+	}
+	args := "first: Int, after: String"
+	if tql.isFlattened(pm) {
+		flattened := tql.setFlattened(pm, fields)
+		for _, name := range []string{"first", "after"} {
+			if _, ok := tql.flattens[pm.Name().UpperCamelCase().String()].Fields[name]; ok {
+				panic(fmt.Sprintf(
+					"%v: the flattened request field %v collides with the connection argument of the same name",
+					pm.FullyQualifiedName(), name,
+				))
+			}
+		}
+		args = strings.Join(append(flattened, args), ", ")
+	} else if len(fields) > 0 {
+		args = "req: " + tql.setConnectionInput(pm.Input(), fields) + ", " + args
+	}
+	return "(" + args + ")"
+}
+
+// setConnectionType declares a type of a connection. Its name
+// is derived from the node's, so it panics when a message of the
+// schema already took it rather than silently replacing either.
+func (tql *gengraphql) setConnectionType(pm pgs.Method, t *serviceType) {
+	_, isType := tql.types[t.Name]
+	_, isInput := tql.inputs[t.Name]
+	_, isEnum := tql.enums[t.Name]
+	_, isUnion := tql.unions[t.Name]
+	if (isType && !tql.connectionTypes[t.Name]) || isInput || isEnum || isUnion {
+		panic(fmt.Sprintf(
+			"%v: the connection type %v collides with another type of the same name",
+			pm.FullyQualifiedName(), t.Name,
+		))
+	}
+	tql.connectionTypes[t.Name] = true
+	tql.types[t.Name] = t
+}
+
+// setConnectionInput declares the input of a connection RPC's
+// request without page_size and page_token, and returns its name.
+// The input is a copy bound to the same message, so that the
+// request can still be an input of other RPCs with all of its fields.
+func (tql *gengraphql) setConnectionInput(msg pgs.Message, fields []pgs.Field) string {
+	msgName, _ := tql.getQualifiedName(msg)
+	name := msgName + "ConnectionInput"
+	if _, ok := tql.inputs[name]; ok {
+		return name
+	}
+	if _, ok := tql.types[name]; ok {
+		panic(fmt.Sprintf("%v: the connection input %v collides with a type of the same name", msg.FullyQualifiedName(), name))
+	}
+	i := &serviceType{
+		Name: name,
+		Doc:  msg.SourceCodeInfo().LeadingComments(),
+	}
+	tql.inputs[name] = i
+	tql.setGraphQLType(name, msg)
+	i.Fields = tql.getFields(fields, false)
+	return name
+}
+
+func getFieldByName(msg pgs.Message, name string) pgs.Field {
+	for _, f := range msg.NonOneOfFields() {
+		if f.Name().String() == name {
+			return f
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package gengraphql
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectionTypeCollision(t *testing.T) {
+	for _, name := range []string{"PageInfo", "BookEdge", "BookConnection"} {
+		t.Run(name, func(t *testing.T) {
+			m, f := getModule(t, "connections")
+			m.types[name] = &serviceType{Name: name}
+			msg := catch(func() { m.generateSchema(f, ioutil.Discard) })
+			require.Contains(t, msg, "connections.Service.ListBooks: the connection type "+name+" collides")
+		})
+	}
+}
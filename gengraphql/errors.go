@@ -0,0 +1,113 @@
+package gengraphql
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	gqlconfig "github.com/99designs/gqlgen/codegen/config"
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/tmc/protoc-gen-graphql/internal/generrors"
+	"github.com/tmc/protoc-gen-graphql/internal/genresolver"
+)
+
+// errorInterface is the GraphQL interface
+// that all the error types implement.
+const errorInterface = "Error"
+
+// setResponseCombination sets the <Rpc>Result union of the
+// response and the responds_with error types of an RPC, which the
+// resolver returns instead of an error when the service fails with
+// one of them. It returns the name of the union.
+func (tql *gengraphql) setResponseCombination(m pgs.Method) string {
+	responseName, _ := tql.getQualifiedName(m.Output())
+	types := []string{responseName}
+	errs := []*genresolver.ErrorType{}
+	for _, name := range getModifiers(m).GetRespondsWith() {
+		msg := tql.lookupMessage(m.File(), name)
+		if msg == nil {
+			panic(fmt.Sprintf("%v: responds_with type %v is not defined", m.FullyQualifiedName(), name))
+		}
+		f := getFieldByName(msg, "message")
+		if f == nil || f.Type().IsRepeated() || f.Type().ProtoType() != pgs.StringT {
+			panic(fmt.Sprintf("%v: error type %v must have a string message field", m.FullyQualifiedName(), name))
+		}
+		tql.setType(msg)
+		typeName, _ := tql.getQualifiedName(msg)
+		if typeName == errorInterface {
+			panic(fmt.Sprintf("%v: %v is reserved for the error interface", msg.FullyQualifiedName(), errorInterface))
+		}
+		if t := tql.types[typeName]; !contains(t.Implements, errorInterface) {
+			t.Implements = append(t.Implements, errorInterface)
+		}
+		types = append(types, typeName)
+		errs = append(errs, &genresolver.ErrorType{
+			ImportPath: tql.deduceImportPath(msg),
+			Type:       tql.ctx.Name(msg).String(),
+		})
+	}
+	unionName := m.Name().UpperCamelCase().String() + "Result"
+	tql.unions[unionName] = &union{
+		Name:  unionName,
+		Types: types,
+	}
+	tql.responseUnions[m.Name().UpperCamelCase().String()] = errs
+	tql.gqlTypes[unionName] = gqlconfig.TypeMapEntry{
+		Model: gqlconfig.StringList{tql.pkgImportPath(tql.modelDir) + "." + tql.unionMask()},
+	}
+	return unionName
+}
+
+// lookupMessage returns the message of a responds_with type name,
+// which is either relative to the package of the file, or fully
+// qualified for messages of the files that it imports.
+func (tql *gengraphql) lookupMessage(f pgs.File, name string) pgs.Message {
+	name = strings.TrimPrefix(name, ".")
+	names := []string{
+		"." + f.Package().ProtoName().String() + "." + name,
+		"." + name,
+	}
+	// File.Imports leaves out the imports that no
+	// message uses, so look the files up by name.
+	files := append([]string{f.Name().String()}, f.Descriptor().GetDependency()...)
+	for _, n := range names {
+		for _, pkg := range tql.pkgs {
+			for _, file := range pkg.Files() {
+				if !contains(files, file.Name().String()) {
+					continue
+				}
+				for _, msg := range file.AllMessages() {
+					if msg.FullyQualifiedName() == n {
+						return msg
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// setErrorInterface adds the interface of the error types.
+func (tql *gengraphql) setErrorInterface(gqlFile *file) {
+	if _, ok := tql.types[errorInterface]; ok {
+		panic(fmt.Sprintf("%v is reserved for the error interface of responds_with types", errorInterface))
+	}
+	gqlFile.Interfaces = append(gqlFile.Interfaces, &serviceType{
+		Name: errorInterface,
+		Doc:  "Error is implemented by the error types that RPCs respond with instead of failing.",
+		Fields: []*serviceField{{
+			Name: "message",
+			Type: "String",
+		}},
+	})
+	tql.gqlTypes[errorInterface] = gqlconfig.TypeMapEntry{
+		Model: gqlconfig.StringList{tql.pkgImportPath(tql.modelDir) + "." + tql.unionMask()},
+	}
+}
+
+func (tql *gengraphql) writeErrors() {
+	f, err := os.Create(tql.pkgPath(tql.resolverDir, "errors.gen.go"))
+	must(err)
+	defer f.Close()
+	must(generrors.Render(tql.pkgName(tql.resolverDir), tql.backend, f))
+}
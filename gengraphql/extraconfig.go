@@ -0,0 +1,95 @@
+package gengraphql
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+
+	gqlconfig "github.com/99designs/gqlgen/codegen/config"
+	"gopkg.in/yaml.v2"
+)
+
+// mergeExtraConfig deep-merges the gqlgen configuration in filename
+// into cfg. Its settings win over the generated ones: maps, such as
+// the models, their fields and the directives, are merged key by
+// key, and any other value, lists included, is replaced. Paths are
+// relative to the working directory, as the generated ones are.
+func (tql *gengraphql) mergeExtraConfig(cfg *gqlconfig.Config, filename string) *gqlconfig.Config {
+	bts, err := ioutil.ReadFile(filename)
+	must(err)
+	var extra interface{}
+	if err := yaml.Unmarshal(bts, &extra); err != nil {
+		panic(fmt.Sprintf("%v: %v", filename, err))
+	}
+	if extra == nil {
+		return cfg
+	}
+	if _, ok := extra.(map[interface{}]interface{}); !ok {
+		panic(fmt.Sprintf("%v: expected a mapping of gqlgen settings", filename))
+	}
+	// Decoding the file alone reports its unknown
+	// settings at their lines in the file.
+	if err := yaml.UnmarshalStrict(bts, &gqlconfig.Config{}); err != nil {
+		panic(fmt.Sprintf("%v: %v", filename, err))
+	}
+	generated, err := yaml.Marshal(cfg)
+	must(err)
+	var merged interface{}
+	must(yaml.Unmarshal(generated, &merged))
+	bts, err = yaml.Marshal(mergeYAML(merged, extra))
+	must(err)
+	res := &gqlconfig.Config{}
+	must(yaml.UnmarshalStrict(bts, res))
+	if err := checkExtraConfig(cfg, res); err != nil {
+		panic(fmt.Sprintf("%v: %v", filename, err))
+	}
+	return res
+}
+
+// mergeYAML merges src into dst, two values decoded from YAML.
+func mergeYAML(dst, src interface{}) interface{} {
+	dm, ok := dst.(map[interface{}]interface{})
+	if !ok {
+		return src
+	}
+	sm, ok := src.(map[interface{}]interface{})
+	if !ok {
+		return src
+	}
+	for k, v := range sm {
+		if dv, ok := dm[k]; ok {
+			dm[k] = mergeYAML(dv, v)
+		} else {
+			dm[k] = v
+		}
+	}
+	return dm
+}
+
+// checkExtraConfig validates the merged configuration. The
+// packages of the executor, models and resolvers can't be
+// changed, since the code that is generated next to them
+// depends on them: they are set by parameters instead.
+func checkExtraConfig(generated, merged *gqlconfig.Config) error {
+	for _, filename := range generated.SchemaFilename {
+		if !merged.SchemaFilename.Has(filename) {
+			return fmt.Errorf("schema must include the generated %v", filename)
+		}
+	}
+	if !reflect.DeepEqual(generated.Exec, merged.Exec) {
+		return fmt.Errorf("exec is set by the output_path and exec_package parameters")
+	}
+	if !reflect.DeepEqual(generated.Model, merged.Model) {
+		return fmt.Errorf("model is set by the output_path and model_package parameters")
+	}
+	if !reflect.DeepEqual(generated.Resolver, merged.Resolver) {
+		return fmt.Errorf("resolver is set by the output_path, resolver_package and resolver_layout parameters")
+	}
+	if merged.Federation.IsDefined() || merged.Federated {
+		return fmt.Errorf("federation is generated from the federation options of the proto file")
+	}
+	if err := merged.Models.Check(); err != nil {
+		return fmt.Errorf("models: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package gengraphql
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtraConfig(t *testing.T) {
+	m, f := getModule(t, "simple")
+	m.generateSchema(f, ioutil.Discard)
+	m.extraConfig = writeExtraConfig(t, `
+autobind:
+- example.com/models
+struct_tag: json
+models:
+  HelloResp:
+    fields:
+      text:
+        resolver: true
+  Time:
+    model:
+    - github.com/99designs/gqlgen/graphql.Time
+directives:
+  auth:
+    skip_runtime: true
+`)
+	var bts bytes.Buffer
+	m.touchConfig(&bts)
+	cfg := bts.String()
+	require.Contains(t, cfg, "autobind:\n- example.com/models\n")
+	require.Contains(t, cfg, "struct_tag: json\n")
+	require.Contains(t, cfg, "  HelloResp:\n    model:\n    - simple.HelloResp\n    fields:\n      text:\n        resolver: true\n")
+	require.Contains(t, cfg, "  Time:\n    model:\n    - github.com/99designs/gqlgen/graphql.Time\n")
+	require.Contains(t, cfg, "  auth:\n    skip_runtime: true\n")
+	require.Contains(t, cfg, "  HelloReq:\n    model:\n    - simple.HelloReq\n")
+}
+
+func TestExtraConfigErrors(t *testing.T) {
+	for extra, msg := range map[string]string{
+		"- autobind":               "expected a mapping of gqlgen settings",
+		"autobnd: []":              "line 1: field autobnd not found",
+		"exec:\n  package: other":  "exec is set by the output_path and exec_package parameters",
+		"resolver:\n  type: Root":  "resolver is set by the output_path, resolver_package and resolver_layout parameters",
+		"schema:\n- extra.graphql": "schema must include the generated gengraphql/schema.graphql",
+		"models:\n  X:\n    model:\n    - example.com/x": "invalid type specifier",
+	} {
+		m, f := getModule(t, "simple")
+		m.generateSchema(f, ioutil.Discard)
+		m.extraConfig = writeExtraConfig(t, extra)
+		err := catch(func() { m.touchConfig(ioutil.Discard) })
+		require.Contains(t, err, m.extraConfig+": ", extra)
+		require.Contains(t, err, msg, extra)
+	}
+}
+
+// catch returns the message that f panics with.
+func TestExtraSchemaOutputs(t *testing.T) {
+	dir := t.TempDir()
+	extra := filepath.Join(dir, "me.graphql")
+	require.NoError(t, ioutil.WriteFile(extra, []byte("extend type Query {\n  me: Me\n}\n\ntype Me {\n  name: String\n}\n"), 0666))
+	m, f := getModule(t, "simple")
+	m.generateSchema(f, ioutil.Discard)
+	m.extraSchema = []string{extra}
+
+	// schema.json holds the extra types and fields, as the Handler serves them.
+	var introspection bytes.Buffer
+	m.renderIntrospection(&introspection)
+	require.Contains(t, introspection.String(), `"name": "me"`)
+	require.Contains(t, introspection.String(), `"name": "Me"`)
+
+	// So does the schema that the breaking check compares.
+	against := filepath.Join(dir, "schema.graphql")
+	require.NoError(t, ioutil.WriteFile(against, []byte(readGolden(t, "simple", "schema.graphql.golden")+"\nextend type Query {\n  me: Me\n}\n\ntype Me {\n  name: String\n}\n"), 0666))
+	require.Equal(t, "<nil>", catch(func() { m.checkBreaking(against) }))
+
+	// The TypeScript types and operations only cover the RPCs.
+	var ts, ops bytes.Buffer
+	m.renderTypeScript(&ts)
+	m.renderOperations(&ops)
+	require.Equal(t, readGolden(t, "simple", "schema.ts.golden"), ts.String())
+	require.Equal(t, readGolden(t, "simple", "operations.graphql.golden"), ops.String())
+}
+
+func catch(f func()) (msg string) {
+	defer func() {
+		msg = fmt.Sprint(recover())
+	}()
+	f()
+	return ""
+}
+
+func writeExtraConfig(t *testing.T, content string) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), "gqlgen.extra.yml")
+	require.NoError(t, ioutil.WriteFile(filename, []byte(content), 0666))
+	return filename
+}
+
+func TestExtraSchema(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.graphql", "b.graphql"} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte("extend type Query {\n  me: String\n}\n"), 0666))
+	}
+	m, f := getModule(t, "simple")
+	m.generateSchema(f, ioutil.Discard)
+	m.extraSchema = []string{filepath.Join(dir, "*.graphql")}
+	var bts bytes.Buffer
+	m.touchConfig(&bts)
+	require.Contains(t, bts.String(), "schema:\n- gengraphql/schema.graphql\n- "+filepath.Join(dir, "a.graphql")+"\n- "+filepath.Join(dir, "b.graphql")+"\n")
+
+	m.extraSchema = []string{filepath.Join(dir, "*.gql")}
+	require.Contains(t, catch(func() { m.touchConfig(ioutil.Discard) }), "extra_schema: no .graphql file matches")
+}
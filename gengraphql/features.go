@@ -0,0 +1,48 @@
+package gengraphql
+
+import (
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fileDescriptor returns the protoreflect view of a proto file.
+// protoc-gen-star only exposes the raw descriptors, which is
+// not enough for proto2 and editions files where the meaning of
+// a field depends on features inherited from its message and file.
+// Letting protodesc resolve them keeps us in line with protoc-gen-go.
+// Imports are left unresolved since features never cross file
+// boundaries.
+func (tql *gengraphql) fileDescriptor(f pgs.File) protoreflect.FileDescriptor {
+	name := f.Descriptor().GetName()
+	if fd, err := tql.files.FindFileByPath(name); err == nil {
+		return fd
+	}
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(f.Descriptor(), tql.files)
+	must(err)
+	must(tql.files.RegisterFile(fd))
+	return fd
+}
+
+// fieldDescriptor returns the protoreflect view of a field
+// with all of its editions features resolved.
+func (tql *gengraphql) fieldDescriptor(pf pgs.Field) protoreflect.FieldDescriptor {
+	tql.fileDescriptor(pf.File())
+	return tql.findDescriptor(pf).(protoreflect.FieldDescriptor)
+}
+
+// enumDescriptor returns the protoreflect view of an enum
+// with all of its editions features resolved.
+func (tql *gengraphql) enumDescriptor(e pgs.Enum) protoreflect.EnumDescriptor {
+	tql.fileDescriptor(e.File())
+	return tql.findDescriptor(e).(protoreflect.EnumDescriptor)
+}
+
+func (tql *gengraphql) findDescriptor(e pgs.Entity) protoreflect.Descriptor {
+	name := strings.TrimPrefix(e.FullyQualifiedName(), ".")
+	d, err := tql.files.FindDescriptorByName(protoreflect.FullName(name))
+	must(err)
+	return d
+}
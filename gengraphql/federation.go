@@ -0,0 +1,208 @@
+package gengraphql
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	gqlconfig "github.com/99designs/gqlgen/codegen/config"
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/tmc/protoc-gen-graphql/gengraphql/options"
+	"github.com/tmc/protoc-gen-graphql/internal/genfederation"
+)
+
+// federationLink is the schema extension that opts
+// the SDL of a subgraph into Apollo Federation v2.
+const federationLink = `extend schema @link(url: "https://specs.apollo.dev/federation/v2.0", import: ["@key", "@shareable", "@external", "FieldSet"])`
+
+// federationDirectives are the definitions of the
+// federation directives that the schema may use.
+var federationDirectives = []string{
+	"directive @key(fields: FieldSet!) on OBJECT | INTERFACE",
+	"directive @shareable on OBJECT | FIELD_DEFINITION",
+	"directive @external on OBJECT | FIELD_DEFINITION",
+}
+
+// setFederationDirectives adds the @key and @shareable directives
+// of a message to its type, along with the @external and @shareable
+// directives of its fields, and records the lookup RPC of an entity.
+func (tql *gengraphql) setFederationDirectives(t *serviceType, msg pgs.Message) {
+	for _, f := range t.Fields {
+		pf := getFieldByName(msg, f.Name)
+		if pf == nil {
+			continue
+		}
+		if getFieldOptions(pf).GetExternal() {
+			f.Directives += " @external"
+		}
+		if getFieldOptions(pf).GetShareable() {
+			f.Directives += " @shareable"
+		}
+	}
+	opts := getMessageOptions(msg)
+	if opts.GetShareable() {
+		t.Directives += " @shareable"
+	}
+	key := opts.GetKey()
+	if key == nil {
+		return
+	}
+	if !tql.federated {
+		panic(fmt.Sprintf("%v: keys are only supported in federated schemas", msg.FullyQualifiedName()))
+	}
+	keyFields := strings.Fields(key.GetFields())
+	if len(keyFields) == 0 || strings.ContainsAny(key.GetFields(), "{}") {
+		panic(fmt.Sprintf("%v: key fields must be a space separated list of field names", msg.FullyQualifiedName()))
+	}
+	for _, name := range keyFields {
+		if getFieldByName(msg, name) == nil {
+			panic(fmt.Sprintf("%v: key field %v is not defined", msg.FullyQualifiedName(), name))
+		}
+	}
+	pm := tql.getLookupMethod(msg, key)
+	entity := &genfederation.Entity{
+		Name:              t.Name,
+		RPC:               pm.Name().UpperCamelCase().String(),
+		RequestImportPath: tql.deduceImportPath(pm.Input()),
+		RequestPkg:        tql.ctx.PackageName(pm.Input()).String(),
+		RequestType:       tql.ctx.Name(pm.Input()).String(),
+		Fields:            map[string]string{},
+	}
+	args := key.GetArgs()
+	if len(args) == 0 {
+		args = map[string]string{}
+		for _, name := range keyFields {
+			args[name] = name
+		}
+	}
+	for reqField, keyField := range args {
+		if getFieldByName(pm.Input(), reqField) == nil {
+			panic(fmt.Sprintf("%v: %v has no field %v", msg.FullyQualifiedName(), pm.Input().Name(), reqField))
+		}
+		if !contains(keyFields, keyField) {
+			panic(fmt.Sprintf("%v: %v is not a key field", msg.FullyQualifiedName(), keyField))
+		}
+		entity.Fields[reqField] = keyField
+	}
+	t.Directives = fmt.Sprintf(" @key(fields: %q)", strings.Join(keyFields, " ")) + t.Directives
+	tql.entities[t.Name] = entity
+}
+
+func (tql *gengraphql) getLookupMethod(msg pgs.Message, key *options.Key) pgs.Method {
+	for _, pm := range tql.svc.Methods() {
+		if pm.Name().String() != key.GetRpc() {
+			continue
+		}
+		if pm.Output().FullyQualifiedName() != msg.FullyQualifiedName() {
+			panic(fmt.Sprintf("%v: key rpc %v must return %v", msg.FullyQualifiedName(), key.GetRpc(), msg.Name()))
+		}
+		return pm
+	}
+	panic(fmt.Sprintf(
+		"%v: key rpc %v is not defined in service %v",
+		msg.FullyQualifiedName(), key.GetRpc(), tql.svc.Name(),
+	))
+}
+
+// setFederation adds the fields, types and directives
+// of a federated schema to the given file.
+func (tql *gengraphql) setFederation(gqlFile *file) {
+	gqlFile.Service.Methods = append(gqlFile.Service.Methods, &method{
+		Name:     "_service",
+		Request:  "",
+		Response: "_Service",
+	})
+	gqlFile.Types = append(gqlFile.Types, &serviceType{
+		Name: "_Service",
+		Fields: []*serviceField{&serviceField{
+			Name: "sdl",
+			Type: "String",
+		}},
+	})
+	tql.gqlTypes["_Service"] = gqlconfig.TypeMapEntry{
+		Model: gqlconfig.StringList{"github.com/99designs/gqlgen/plugin/federation/fedruntime.Service"},
+	}
+	gqlFile.Directives = append(gqlFile.Directives, federationDirectives...)
+	gqlFile.Scalars = append(gqlFile.Scalars, "FieldSet")
+	tql.gqlTypes["FieldSet"] = gqlconfig.TypeMapEntry{
+		Model: gqlconfig.StringList{"github.com/99designs/gqlgen/graphql.String"},
+	}
+	if len(tql.entities) == 0 {
+		return
+	}
+	gqlFile.Service.Methods = append(gqlFile.Service.Methods, &method{
+		Name:     "_entities",
+		Request:  "(representations: [_Any!]!)",
+		Response: "[_Entity]",
+	})
+	gqlFile.Scalars = append(gqlFile.Scalars, "_Any")
+	tql.gqlTypes["_Any"] = gqlconfig.TypeMapEntry{
+		Model: gqlconfig.StringList{"github.com/99designs/gqlgen/graphql.Map"},
+	}
+	names := []string{}
+	for name := range tql.entities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	tql.unions["_Entity"] = &union{Name: "_Entity", Types: names}
+	gqlFile.Unions = append(gqlFile.Unions, tql.unions["_Entity"])
+	tql.gqlTypes["_Entity"] = gqlconfig.TypeMapEntry{
+		Model: gqlconfig.StringList{tql.pkgImportPath(tql.modelDir) + "." + tql.unionMask()},
+	}
+}
+
+func (tql *gengraphql) writeFederation() {
+	external := tql.execDir != tql.resolverDir
+	if external && tql.backend == "connect" {
+		panic("federation with the connect backend needs the exec_package and resolver_package to be the same")
+	}
+	f, err := os.Create(tql.pkgPath(tql.execDir, "federation.gen.go"))
+	must(err)
+	defer f.Close()
+	importPath, pkg := tql.servicePackage()
+	modelImportPath, modelPkg := tql.packagePaths(tql.modelDir, tql.execDir)
+	data := &genfederation.Data{
+		PackageName:       tql.pkgName(tql.execDir),
+		ServiceImportPath: importPath,
+		ServicePkg:        pkg,
+		ServiceName:       tql.serviceType(),
+		SDL:               tql.sdl,
+		ModelImportPath:   modelImportPath,
+		ModelPkg:          modelPkg,
+		ExternalResolvers: external,
+	}
+	names := []string{}
+	for name := range tql.entities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		data.Entities = append(data.Entities, tql.entities[name])
+	}
+	must(genfederation.Render(data, f))
+}
+
+func getMessageOptions(msg pgs.Message) *options.Message {
+	opts := msg.Descriptor().GetOptions()
+	if proto.HasExtension(opts, options.E_Message) {
+		message, err := proto.GetExtension(opts, options.E_Message)
+		must(err)
+		val, ok := message.(*options.Message)
+		if !ok {
+			panic(fmt.Sprintf("invalid message type: %T\n", message))
+		}
+		return val
+	}
+	return nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
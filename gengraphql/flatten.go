@@ -0,0 +1,58 @@
+package gengraphql
+
+import (
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/tmc/protoc-gen-graphql/internal/genresolver"
+)
+
+// isFlattened reports whether the request fields of an RPC
+// are exposed as individual arguments, either because of
+// (gengraphql.options.rpc).flatten or because the schema
+// or the flatten plugin parameter flatten every RPC.
+func (tql *gengraphql) isFlattened(pm pgs.Method) bool {
+	return getModifiers(pm).GetFlatten() ||
+		tql.flatten ||
+		getSchemaOptions(pm.File()).GetFlatten()
+}
+
+// setFlattened returns the GraphQL arguments of the given
+// request fields and records how the resolver can rebuild
+// the request from them.
+func (tql *gengraphql) setFlattened(pm pgs.Method, protoFields []pgs.Field) []string {
+	flatten := &genresolver.Flatten{
+		RequestImportPath: tql.deduceImportPath(pm.Input()),
+		RequestType:       tql.ctx.Name(pm.Input()).String(),
+		Fields:            map[string]string{},
+	}
+	tql.flattens[pm.Name().UpperCamelCase().String()] = flatten
+	args := []string{}
+	for _, pf := range protoFields {
+		fields := tql.getFields([]pgs.Field{pf}, false)
+		if len(fields) == 0 {
+			continue
+		}
+		f := fields[0]
+		flatten.Fields[f.Name] = tql.ctx.Name(pf).String()
+		arg := f.Name + ": " + f.Type
+		if f.Required {
+			arg += "!"
+		}
+		if f.Default != "" {
+			arg += " = " + f.Default
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// formatFlattenedInput is the flattened
+// counterpart of formatQueryInput.
+func (tql *gengraphql) formatFlattenedInput(pm pgs.Method) string {
+	args := tql.setFlattened(pm, pm.Input().NonOneOfFields())
+	if len(args) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(args, ", ") + ")"
+}
@@ -0,0 +1,22 @@
+package gengraphql
+
+import (
+	"io/ioutil"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenedConnectionCollision(t *testing.T) {
+	ast := buildGraph(t, "flatten", "collision")
+	f := ast.Targets()["collision.proto"]
+	m := New("collision").(*gengraphql)
+	m.ctx = pgsgo.InitContext(pgs.ParseParameters(""))
+	m.svc = f.Services()[0]
+	m.protopkg = f.Package()
+	m.pkgs = ast.Packages()
+	msg := catch(func() { m.generateSchema(f, ioutil.Discard) })
+	require.Contains(t, msg, "collision.Service.ListBooks: the flattened request field first collides with the connection argument")
+}
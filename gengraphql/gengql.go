@@ -2,29 +2,40 @@ package gengraphql
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"go/token"
 	"io"
 	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/99designs/gqlgen/api"
 	gqlconfig "github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/plugin"
 	"github.com/99designs/gqlgen/plugin/modelgen"
 	"github.com/golang/protobuf/proto"
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
 	"github.com/tmc/protoc-gen-graphql/gengraphql/options"
+	"github.com/tmc/protoc-gen-graphql/internal/genconnections"
 	"github.com/tmc/protoc-gen-graphql/internal/genenums"
+	"github.com/tmc/protoc-gen-graphql/internal/genfederation"
+	"github.com/tmc/protoc-gen-graphql/internal/genloaders"
 	"github.com/tmc/protoc-gen-graphql/internal/genresolver"
 	"github.com/tmc/protoc-gen-graphql/internal/genscalar"
 	"github.com/tmc/protoc-gen-graphql/internal/genserver"
 	"github.com/tmc/protoc-gen-graphql/internal/genunions"
+	"github.com/tmc/protoc-gen-graphql/internal/gomod"
 	"github.com/tmc/protoc-gen-graphql/internal/gqlfmt"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"gopkg.in/yaml.v2"
 )
 
@@ -55,13 +66,42 @@ type gengraphql struct {
 	unions     map[string]*union
 	unionNames map[string]bool
 
-	// responseUnions represent the name
-	// of all the RPCs that want their
-	// responses combined with an error type
+	// connections are the RPCs, by name, that
+	// are wrapped in Relay connections so that
+	// the resolver can translate first/after
+	// into page_size/page_token and back.
+	connections map[string]*genresolver.Connection
+
+	// connectionTypes are the names of the
+	// connection, edge and page info types
+	// so that messages can't take them.
+	connectionTypes map[string]bool
+
+	// flattens are the RPCs, by name, whose
+	// request fields are individual arguments
+	// so that the resolver can rebuild the request.
+	flattens map[string]*genresolver.Flatten
+
+	// fieldResolvers are the fields, keyed by
+	// Type.field, that are resolved by calling
+	// another RPC of the service.
+	fieldResolvers map[string]*genresolver.FieldResolver
+
+	// loaders are the DataLoaders, by RPC name,
+	// of the RPCs marked as batch.
+	loaders map[string]*genloaders.Loader
+
+	// entities are the federated entities, by
+	// GraphQL name, of the messages with a key.
+	entities map[string]*genfederation.Entity
+
+	// responseUnions map the Go names of the
+	// RPCs that want their responses combined
+	// with error types to those error types.
 	// This way, the resolver can replace
-	// a response with the error type
+	// a response with an error type
 	// instead of actually returning the error.
-	responseUnions map[string]string
+	responseUnions map[string][]*genresolver.ErrorType
 
 	// an empty type keeps track of empty returns
 	// because GraphQL Types can't be empty
@@ -91,8 +131,15 @@ type gengraphql struct {
 	// like map[string]*ptypes.Timestamp
 	mapImports map[string]struct{}
 
+	// sdl is the schema of a federated subgraph, as
+	// returned by _service. It is printed from the
+	// same GraphQL schema as schema.graphql.
 	sdl string
 
+	// federated is set when the target file
+	// is a federated schema, see options.Schema.
+	federated bool
+
 	// gqlTypes are specific for the gqlgen config file
 	// so that we make all the input/output GraphQL
 	// types point to the generated .pb.go types.
@@ -105,8 +152,8 @@ type gengraphql struct {
 	// a protobuf file.
 	ctx pgsgo.Context
 
-	// modname is the import path that "go list"
-	// returns from inside the target .proto file
+	// modname is the import path of the Go
+	// package of the target .proto file
 	modname string
 
 	// gopkgname is the `option go_package` value
@@ -121,15 +168,91 @@ type gengraphql struct {
 	// live. It defaults to a "gengraphql".
 	destpkgname string
 
+	// pkgname is the name of the Go package in
+	// destpkgname, which defaults to the name
+	// of its directory.
+	pkgname string
+
+	// execDir, resolverDir and modelDir are the directories,
+	// relative to destpkgname, of the packages of gqlgen's
+	// executor, of the resolvers and Handler, and of the models.
+	execDir     string
+	resolverDir string
+	modelDir    string
+
+	// resolverLayout is gqlgen's layout of the resolvers,
+	// single-file or follow-schema, which keeps the resolvers
+	// that users write apart from the generated ones.
+	resolverLayout gqlconfig.ResolverLayout
+
+	// extraConfig is a gqlgen.yml whose settings are
+	// merged into the generated configuration.
+	extraConfig string
+
 	// enableGqlgen controls whether full gqlgen-based servers are generated.
 	enableGqlgen bool
 
+	// inferOperations turns on infer_operations
+	// for every file, see options.Schema.
+	inferOperations bool
+
+	// flatten turns on flatten for
+	// every file, see options.Schema.
+	flatten bool
+
+	// backend is the transport that the resolvers call
+	// the service with: "twirp", "grpc" or "connect".
+	backend string
+
+	// client turns on the Go client of the schema.
+	client bool
+
+	// typescript turns on the TypeScript types and the
+	// operations document, whose selection sets are
+	// tsDepth levels of nested objects deep.
+	typescript bool
+	tsDepth    int
+
+	// introspection turns on the schema.json
+	// introspection result of the schema.
+	introspection bool
+
+	// gqlFile is the model of the GraphQL schema
+	// and schema is the schema parsed from it.
+	gqlFile *file
+	schema  *ast.Schema
+
+	// printed is schema.graphql as printed by gqlfmt.
+	printed string
+
 	// is the import path that will import
 	// the gengraphql sub-package
 	destimportpath string
 
 	svc      pgs.Service
 	protopkg pgs.Package
+
+	// pkgs are the packages of the target file
+	// and of the files that it imports.
+	pkgs map[string]pgs.Package
+
+	// files caches the protoreflect descriptors
+	// used to resolve proto2 and editions features.
+	files *protoregistry.Files
+
+	// resolver finds the import paths of
+	// the directories of .proto files.
+	resolver *gomod.Resolver
+
+	// extraSchema are the patterns of the .graphql files that
+	// extend the generated schema, such as the fields of
+	// hand-written resolvers, separated by ";" in the
+	// extra_schema parameter.
+	extraSchema []string
+
+	// plugins are the gqlgen plugins that run
+	// after the ones of protoc-gen-graphql.
+	plugins []plugin.Plugin
 }
 
 type enumData struct {
@@ -138,29 +261,64 @@ type enumData struct {
 	PackageName string
 	Values      []*serviceField
 	Doc         string
+	Closed      bool
+}
+
+// Option configures the module returned by NewWithOptions.
+type Option func(*gengraphql)
+
+// WithGqlgenPlugins adds gqlgen plugins, which generate code from
+// the schema and gqlgen.yml after the plugins of the module.
+func WithGqlgenPlugins(plugins ...plugin.Plugin) Option {
+	return func(tql *gengraphql) {
+		tql.plugins = append(tql.plugins, plugins...)
+	}
 }
 
 // New configures the module with an instance of ModuleBase
 func New(importPath string) pgs.Module {
+	return NewWithOptions(importPath)
+}
 
-	return &gengraphql{
-		ModuleBase:     &pgs.ModuleBase{},
-		inputs:         map[string]*serviceType{},
-		types:          map[string]*serviceType{},
-		emptys:         map[string]bool{},
-		enums:          map[string]*enumData{},
-		maps:           map[string]string{},
-		mapImports:     map[string]struct{}{},
-		unions:         map[string]*union{},
-		unionNames:     map[string]bool{},
-		responseUnions: map[string]string{},
-		gqlTypes:       gqlconfig.TypeMap{},
-		tmpl:           template.Must(template.New("").Funcs(tmplFuncs()).Parse(schemaTemplate)),
-		modname:        importPath,
-		ctx:            pgsgo.InitContext(pgs.ParseParameters("")),
-		destpkgname:    "gengraphql",
-		destimportpath: "",
+// NewWithOptions configures the module with an
+// instance of ModuleBase and the given options.
+func NewWithOptions(importPath string, opts ...Option) pgs.Module {
+	tql := &gengraphql{
+		ModuleBase:      &pgs.ModuleBase{},
+		inputs:          map[string]*serviceType{},
+		types:           map[string]*serviceType{},
+		emptys:          map[string]bool{},
+		enums:           map[string]*enumData{},
+		maps:            map[string]string{},
+		mapImports:      map[string]struct{}{},
+		unions:          map[string]*union{},
+		unionNames:      map[string]bool{},
+		responseUnions:  map[string][]*genresolver.ErrorType{},
+		connections:     map[string]*genresolver.Connection{},
+		connectionTypes: map[string]bool{},
+		flattens:        map[string]*genresolver.Flatten{},
+		fieldResolvers:  map[string]*genresolver.FieldResolver{},
+		loaders:         map[string]*genloaders.Loader{},
+		entities:        map[string]*genfederation.Entity{},
+		gqlTypes:        gqlconfig.TypeMap{},
+		tmpl:            template.Must(template.New("").Funcs(tmplFuncs()).Parse(schemaTemplate)),
+		modname:         importPath,
+		ctx:             pgsgo.InitContext(pgs.ParseParameters("")),
+		destpkgname:     "gengraphql",
+		pkgname:         "gengraphql",
+		execDir:         ".",
+		resolverDir:     ".",
+		modelDir:        ".",
+		resolverLayout:  gqlconfig.LayoutSingleFile,
+		tsDepth:         3,
+		destimportpath:  "",
+		files:           &protoregistry.Files{},
+		resolver:        gomod.NewResolver(),
 	}
+	for _, opt := range opts {
+		opt(tql)
+	}
+	return tql
 }
 
 // Name is the identifier used to identify the module. This value is
@@ -178,43 +336,67 @@ func (tql *gengraphql) InitContext(c pgs.BuildContext) {
 // outside of the normal protoc flow.
 func (tql *gengraphql) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Package) []pgs.Artifact {
 	tql.destpkgname = tql.Parameters().StrDefault("output_path", tql.destpkgname)
+	tql.pkgname = tql.Parameters().StrDefault("package", packageName(tql.destpkgname))
+	if !token.IsIdentifier(tql.pkgname) {
+		panic(fmt.Sprintf("package must be a Go identifier, got: %q", tql.pkgname))
+	}
+	tql.execDir = tql.packageDir("exec_package", tql.execDir)
+	tql.resolverDir = tql.packageDir("resolver_package", tql.resolverDir)
+	tql.modelDir = tql.packageDir("model_package", tql.modelDir)
+	tql.resolverLayout = gqlconfig.ResolverLayout(tql.Parameters().StrDefault("resolver_layout", string(tql.resolverLayout)))
+	if tql.resolverLayout != gqlconfig.LayoutSingleFile && tql.resolverLayout != gqlconfig.LayoutFollowSchema {
+		panic(fmt.Sprintf("resolver_layout must be one of %v or %v, got: %v", gqlconfig.LayoutSingleFile, gqlconfig.LayoutFollowSchema, tql.resolverLayout))
+	}
+	tql.extraConfig = tql.Parameters().Str("extra_config")
+	if extra := tql.Parameters().Str("extra_schema"); extra != "" {
+		tql.extraSchema = strings.Split(extra, ";")
+	}
 	tql.enableGqlgen, _ = tql.Parameters().BoolDefault("gqlgen", true)
+	tql.inferOperations, _ = tql.Parameters().BoolDefault("infer_operations", false)
+	tql.flatten, _ = tql.Parameters().BoolDefault("flatten", false)
+	tql.backend = tql.Parameters().StrDefault("backend", "twirp")
+	if tql.backend != "twirp" && tql.backend != "grpc" && tql.backend != "connect" {
+		panic("backend must be one of twirp, grpc or connect, got: " + tql.backend)
+	}
+	tql.client, _ = tql.Parameters().BoolDefault("client", false)
+	tql.typescript, _ = tql.Parameters().BoolDefault("ts_out", false)
+	tql.introspection, _ = tql.Parameters().BoolDefault("introspection", false)
+	tql.tsDepth, _ = tql.Parameters().IntDefault("ts_depth", tql.tsDepth)
+	if tql.tsDepth < 1 {
+		panic(fmt.Sprintf("ts_depth must be at least 1, got: %v", tql.tsDepth))
+	}
 
 	if len(targets) != 1 {
 		panic("only one proto file is supported at this moment")
 	}
+	tql.pkgs = pkgs
 
 	for fileName, targetFile := range targets {
-		if targetFile.Syntax() != pgs.Proto3 {
-			panic("only proto3 is supported")
-		}
 		tql.svc = tql.pickServiceFromFile(tql.Parameters().Str("service"), targetFile)
 		if len(tql.svc.Methods()) == 0 {
 			panic("service must have at least on rpc")
 		}
 		tql.protopkg = targetFile.Package()
 		serviceDir := filepath.Dir(fileName)
-		tql.setImportPath(serviceDir)
-		if serviceDir == "." {
-			tql.destimportpath = tql.modname
-		} else {
-			tql.destimportpath = tql.goList(".")
-		}
-		var schemaBuffer bytes.Buffer
-		f, err := os.Create(tql.path("schema.graphql"))
-		must(err)
-		defer f.Close()
-		tql.generateSchema(targetFile, io.MultiWriter(&schemaBuffer, f))
-		if tql.isFederated(targetFile) {
-			tql.sdl = strings.Replace(schemaBuffer.String(), "type Query", "extend type Query", 1)
+		tql.setImportPath(serviceDir, targetFile)
+		tql.setDestImportPath(serviceDir)
+		var schema bytes.Buffer
+		tql.generateSchema(targetFile, &schema)
+		if against := tql.Parameters().Str("breaking_against"); against != "" {
+			tql.checkBreaking(against)
 		}
+		must(os.MkdirAll(tql.destpkgname, 0755))
+		must(ioutil.WriteFile(tql.path("schema.graphql"), schema.Bytes(), 0666))
 	}
 	if tql.enableGqlgen {
+		for _, dir := range []string{tql.execDir, tql.resolverDir, tql.modelDir} {
+			must(os.MkdirAll(tql.pkgPath(dir, ""), 0755))
+		}
 		if len(tql.maps) > 0 {
-			f, err := os.Create(tql.path("scalars.go"))
+			f, err := os.Create(tql.pkgPath(tql.modelDir, "scalars.go"))
 			must(err)
 			defer f.Close()
-			must(genscalar.Render(tql.maps, tql.mapImports, f))
+			must(genscalar.Render(tql.pkgName(tql.modelDir), tql.maps, tql.mapImports, f))
 		}
 
 		f, err := os.Create(tql.path("gqlgen.yml"))
@@ -227,11 +409,48 @@ func (tql *gengraphql) Execute(targets map[string]pgs.File, pkgs map[string]pgs.
 		if len(tql.unions) > 0 {
 			tql.writeUnionMask()
 		}
-		tql.initGql(tql.svcname)
+		if len(tql.connections) > 0 {
+			tql.writeConnections()
+		}
+		if len(tql.loaders) > 0 {
+			tql.writeLoaders()
+		}
+		if tql.federated {
+			tql.writeFederation()
+		}
+		if tql.backend == "connect" {
+			tql.writeConnect()
+		}
+		if len(tql.responseUnions) > 0 {
+			tql.writeErrors()
+		}
+		tql.initGql(tql.serviceType())
+	}
+	if tql.client {
+		tql.writeClient()
+	}
+	if tql.typescript {
+		tql.writeTypeScript()
+	}
+	if tql.introspection {
+		tql.writeIntrospection()
 	}
 	return tql.Artifacts()
 }
 
+// serviceType is the name of the Go interface that the resolvers
+// call: the Twirp service interface, the gRPC client interface,
+// or the connectService that adapts a connect client.
+func (tql *gengraphql) serviceType() string {
+	switch tql.backend {
+	case "grpc":
+		return tql.svcname + "Client"
+	case "connect":
+		return "connectService"
+	}
+	return tql.svcname
+}
+
 func (tql *gengraphql) pickServiceFromFile(svc string, f pgs.File) pgs.Service {
 	switch len(f.Services()) {
 	case 0:
@@ -250,36 +469,72 @@ func (tql *gengraphql) pickServiceFromFile(svc string, f pgs.File) pgs.Service {
 	panic("protofile does not have the given service: " + svc)
 }
 
-func (tql *gengraphql) goList(dir string) string {
-	cmd := exec.Command("go", "list")
-	cmd.Dir = dir
-	cmd.Env = os.Environ()
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	pkgpath, err := cmd.Output()
+// importPath returns the import path of the Go package in dir,
+// relative to the working directory, from the go.mod and go.work
+// files above it.
+func (tql *gengraphql) importPath(dir string) string {
+	p, err := tql.resolver.ImportPath(dir)
 	if err != nil {
-		msg := fmt.Sprintf("go list failed: %v - stdout: %v - stderr: %v", err, string(pkgpath), stderr.String())
-		if strings.Contains(stderr.String(), "cannot find module providing package") {
-			msg = "go list failed. Make sure you have .go files where your .proto file is." +
-				"Also make sure to run the --go_out=. plugin a separate command before you run --gql_out"
+		panic(fmt.Sprintf("cannot find the import path of %v, set the importpath parameter: %v", dir, err))
+	}
+	return p
+}
+
+// setImportPath sets the import path of the Go package of the
+// service, from the importpath parameter, the file's go_package
+// or else the module of the .proto file's directory.
+func (tql *gengraphql) setImportPath(serviceDir string, f pgs.File) {
+	if importPath := tql.Parameters().Str("importpath"); importPath != "" {
+		tql.modname = importPath
+		return
+	}
+	if gopkg, ok := goPackage(f); ok {
+		tql.modname = gopkg
+		return
+	}
+	tql.modname = tql.importPath(serviceDir)
+}
+
+// setDestImportPath sets the import path of the working directory,
+// which the generated code's output_path is relative to: the
+// importpath parameter when the .proto file is in it, or else the
+// import path from the go.mod and go.work files. The file's
+// go_package is only used outside of a Go module, since the package
+// that it names may be generated elsewhere, as with --go_out=module=.
+func (tql *gengraphql) setDestImportPath(serviceDir string) {
+	if serviceDir == "." {
+		if importPath := tql.Parameters().Str("importpath"); importPath != "" {
+			tql.destimportpath = importPath
+			return
+		}
+		if p, err := tql.resolver.ImportPath("."); err == nil {
+			tql.destimportpath = p
+			return
 		}
-		panic(msg)
+		tql.destimportpath = tql.modname
+		return
 	}
-	return strings.TrimSpace(string(pkgpath))
+	tql.destimportpath = tql.importPath(".")
 }
 
-func (tql *gengraphql) setImportPath(serviceDir string) {
-	modname := tql.goList(serviceDir)
-	tql.modname = tql.Parameters().StrDefault("importpath", modname)
-	if tql.modname == "" {
-		panic("import path must be provided by `go list` in the .proto directory or through the importpath plugin parameter")
+// goPackage returns the import path of a file's go_package
+// option, unless the option only names the package.
+func goPackage(f pgs.File) (string, bool) {
+	gopkg := f.Descriptor().GetOptions().GetGoPackage()
+	if i := strings.LastIndex(gopkg, ";"); i > -1 {
+		gopkg = gopkg[:i]
+	}
+	if !strings.Contains(gopkg, "/") || strings.HasPrefix(gopkg, ".") {
+		return "", false
 	}
+	return gopkg, true
 }
 
 func (tql *gengraphql) generateSchema(f pgs.File, out io.Writer) {
 	out.Write([]byte("# Code was generated by github.com/tmc/protoc-gen-graphql. DO NOT EDIT.\n\n"))
 	tql.svcname = tql.svc.Name().String()
 	tql.gopkgname = tql.ctx.PackageName(f).String()
+	tql.federated = tql.isFederated(f)
 	gqlFile := &file{}
 	gqlFile.Service = tql.getService(tql.svc)
 	// inputs
@@ -344,19 +599,11 @@ func (tql *gengraphql) generateSchema(f pgs.File, out io.Writer) {
 
 		}
 	}
-	if tql.isFederated(f) {
-		gqlFile.Service.Methods = append(gqlFile.Service.Methods, &method{
-			Name:     "_service",
-			Request:  "",
-			Response: "_Service",
-		})
-		gqlFile.Types = append(gqlFile.Types, &serviceType{
-			Name: "_Service",
-			Fields: []*serviceField{&serviceField{
-				Name: "sdl",
-				Type: "String",
-			}},
-		})
+	if len(tql.responseUnions) > 0 {
+		tql.setErrorInterface(gqlFile)
+	}
+	if tql.federated {
+		tql.setFederation(gqlFile)
 	}
 
 	var buf bytes.Buffer
@@ -365,18 +612,61 @@ func (tql *gengraphql) generateSchema(f pgs.File, out io.Writer) {
 	must(err)
 	// TODO: allow output of invalid?
 	ioutil.WriteFile("/tmp/gengql.graphql", buf.Bytes(), 0644)
-	/*
-		fc, _ := ioutil.ReadFile("/tmp/gengql.graphql")
-		err = gqlfmt.Print(string(fc), out)
-	*/
-	err = gqlfmt.Print(buf.String(), out)
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{
+		Name:  "schema.graphql",
+		Input: buf.String(),
+	})
+	if gqlErr != nil {
+		panic(gqlErr)
+	}
+	tql.gqlFile = gqlFile
+	tql.schema = schema
+	formatted, err := gqlfmt.PrintSchema(schema)
+	must(err)
+	tql.printed = formatted
+	_, err = io.WriteString(out, formatted)
 	must(err)
+	if tql.federated {
+		tql.sdl, err = gqlfmt.PrintSubgraph(schema, federationLink)
+		must(err)
+	}
+}
+
+// extraSchemaFiles returns the files that
+// the patterns of extra_schema match.
+func (tql *gengraphql) extraSchemaFiles() []string {
+	files := []string{}
+	for _, pattern := range tql.extraSchema {
+		matches, err := filepath.Glob(pattern)
+		must(err)
+		if len(matches) == 0 {
+			panic(fmt.Sprintf("extra_schema: no .graphql file matches %v", pattern))
+		}
+		files = append(files, matches...)
+	}
+	return files
+}
+
+// servedSchema returns the schema that the Handler serves:
+// the printed schema, extended by the extra_schema files.
+func (tql *gengraphql) servedSchema() *ast.Schema {
+	sources := []*ast.Source{{Name: "schema.graphql", Input: tql.printed}}
+	for _, filename := range tql.extraSchemaFiles() {
+		bts, err := ioutil.ReadFile(filename)
+		must(err)
+		sources = append(sources, &ast.Source{Name: filename, Input: string(bts)})
+	}
+	schema, gqlErr := gqlparser.LoadSchema(sources...)
+	if gqlErr != nil {
+		panic(gqlErr)
+	}
+	return schema
 }
 
 // bridgeEnums creates a type conversion between
 // protobuf's enums (int32) and GraphQL's enums (string).
 func (tql *gengraphql) bridgeEnums() {
-	f, err := os.Create(tql.path("enums.gen.go"))
+	f, err := os.Create(tql.pkgPath(tql.execDir, "enums.gen.go"))
 	must(err)
 	defer f.Close()
 	all := []*genenums.Data{}
@@ -386,20 +676,35 @@ func (tql *gengraphql) bridgeEnums() {
 			Pkg:        v.PackageName,
 			Name:       k,
 			GoName:     v.Name,
+			Closed:     v.Closed,
 		})
 	}
-	must(genenums.Render(all, f))
+	must(genenums.Render(tql.pkgName(tql.execDir), all, f))
 }
 
 func (tql *gengraphql) touchConfig(out io.Writer) {
 	out.Write([]byte("# Code was generated by github.com/tmc/protoc-gen-graphql. DO NOT EDIT.\n\n"))
-	var cfg gqlconfig.Config
-	cfg.SchemaFilename = gqlconfig.StringList{tql.path("schema.graphql")}
-	cfg.Exec = gqlconfig.PackageConfig{Filename: tql.path("generated.go")}
-	cfg.Resolver = gqlconfig.ResolverConfig{Filename: tql.path("resolver.go"), Type: "Resolver"}
+	cfg := &gqlconfig.Config{}
+	cfg.SchemaFilename = append(gqlconfig.StringList{tql.path("schema.graphql")}, tql.extraSchemaFiles()...)
+	cfg.Exec = gqlconfig.PackageConfig{Filename: tql.pkgPath(tql.execDir, "generated.go"), Package: tql.pkgName(tql.execDir)}
+	cfg.Resolver = gqlconfig.ResolverConfig{Filename: tql.pkgPath(tql.resolverDir, "resolver.go"), Package: tql.pkgName(tql.resolverDir), Type: "Resolver"}
+	if tql.resolverLayout == gqlconfig.LayoutFollowSchema {
+		cfg.Resolver.Layout = tql.resolverLayout
+		cfg.Resolver.DirName = tql.pkgPath(tql.resolverDir, "")
+	}
 	cfg.Models = tql.gqlTypes
-	cfg.Model = gqlconfig.PackageConfig{Filename: tql.path("models_gen.go")}
-	must(yaml.NewEncoder(out).Encode(&cfg))
+	cfg.Model = gqlconfig.PackageConfig{Filename: tql.pkgPath(tql.modelDir, "models_gen.go"), Package: tql.pkgName(tql.modelDir)}
+	if tql.federated {
+		cfg.Directives = map[string]gqlconfig.DirectiveConfig{
+			"key":       {SkipRuntime: true},
+			"shareable": {SkipRuntime: true},
+			"external":  {SkipRuntime: true},
+		}
+	}
+	if tql.extraConfig != "" {
+		cfg = tql.mergeExtraConfig(cfg, tql.extraConfig)
+	}
+	must(yaml.NewEncoder(out).Encode(cfg))
 }
 
 func (tql *gengraphql) initGql(svcName string) {
@@ -409,23 +714,34 @@ func (tql *gengraphql) initGql(svcName string) {
 	for k := range tql.emptys {
 		emptys = append(emptys, k)
 	}
+	serviceImportPath, servicePkg := tql.servicePackage()
+	modPath := tql.modname
+	if tql.backend == "connect" {
+		modPath, _ = tql.connectPackage()
+	}
 
-	err = api.Generate(
-		cfg,
+	opts := []api.Option{
 		api.NoPlugins(),
 		api.AddPlugin(modelgen.New()),
 		api.AddPlugin(genresolver.New(
 			svcName,
-			tql.gopkgname,
+			tql.path("schema.graphql"),
+			serviceImportPath,
+			servicePkg,
 			emptys,
 			tql.maps,
 			tql.unionNames,
 			tql.responseUnions,
-			tql.sdl,
+			tql.connections,
+			tql.flattens,
+			tql.fieldResolvers,
 		)),
-		api.AddPlugin(genserver.New(tql.path("server.go"), tql.modname, svcName)),
-	)
-	must(err)
+		api.AddPlugin(genserver.New(tql.pkgPath(tql.resolverDir, "server.go"), tql.pkgName(tql.resolverDir), modPath, tql.svcname, tql.backend, len(tql.loaders) > 0)),
+	}
+	for _, p := range tql.plugins {
+		opts = append(opts, api.AddPlugin(p))
+	}
+	must(api.Generate(cfg, opts...))
 }
 
 func (tql *gengraphql) getService(svc pgs.Service) *service {
@@ -441,7 +757,10 @@ func (tql *gengraphql) getMethods(protoMethods []pgs.Method) ([]*method, []*meth
 	// collect all types first, so that we de-dupe mixed
 	// inputs && types
 	for _, pm := range protoMethods {
-		tql.setType(pm.Output())
+		tql.getLoader(pm)
+		if conn, _ := tql.getConnection(pm); conn == nil {
+			tql.setType(pm.Output())
+		}
 	}
 
 	for _, pm := range protoMethods {
@@ -451,16 +770,23 @@ func (tql *gengraphql) getMethods(protoMethods []pgs.Method) ([]*method, []*meth
 		var m method
 		m.Name = pm.Name().LowerCamelCase().String()
 		m.Doc = pm.SourceCodeInfo().LeadingComments()
-		// TODO: make oneOf fields a scalar in inputs
-		emptyInput := len(pm.Input().NonOneOfFields()) == 0
-		if !emptyInput {
-			tql.setInput(pm.Input())
-			m.Request = tql.formatQueryInput(pm.Input())
-		}
-		if tql.hasResponseCombination(pm) {
-			m.Response = tql.setResponseCombination(pm)
+		if conn, node := tql.getConnection(pm); conn != nil {
+			m.Request = tql.setConnection(pm, conn, node)
+			m.Response = conn.ConnectionType
 		} else {
-			m.Response, _ = tql.getQualifiedName(pm.Output())
+			// TODO: make oneOf fields a scalar in inputs
+			emptyInput := len(pm.Input().NonOneOfFields()) == 0
+			if !emptyInput && tql.isFlattened(pm) {
+				m.Request = tql.formatFlattenedInput(pm)
+			} else if !emptyInput {
+				tql.setInput(pm.Input())
+				m.Request = tql.formatQueryInput(pm.Input())
+			}
+			if tql.hasResponseCombination(pm) {
+				m.Response = tql.setResponseCombination(pm)
+			} else {
+				m.Response, _ = tql.getQualifiedName(pm.Output())
+			}
 		}
 		if tql.isMutation(pm) {
 			mutations = append(mutations, &m)
@@ -471,61 +797,32 @@ func (tql *gengraphql) getMethods(protoMethods []pgs.Method) ([]*method, []*meth
 	return methods, mutations
 }
 
-func (tql *gengraphql) setResponseCombination(m pgs.Method) string {
-	rpc := getModifiers(m)
-	typeName := rpc.GetRespondsWith()[0]
-	f := m.File()
-	var msg pgs.Message
-	for _, m := range f.Messages() {
-		if typeName == m.Name().String() {
-			msg = m
-		}
-	}
-	if msg == nil {
-		panic(typeName + " is not defined in proto file")
-	}
-	tql.setType(msg)
-	responseName, _ := tql.getQualifiedName(m.Output())
-	unionName := responseName + "Set"
-	tql.unions[unionName] = &union{
-		Name:  unionName,
-		Types: []string{responseName, typeName},
-	}
-	tql.responseUnions[m.Name().UpperCamelCase().String()] = typeName
-	importpath := tql.destimportpath + "/" + tql.destpkgname
-	tql.gqlTypes[unionName] = gqlconfig.TypeMapEntry{
-		Model: gqlconfig.StringList{importpath + "." + "unionMask"},
-	}
-	return unionName
-}
-
 func (tql *gengraphql) hasResponseCombination(m pgs.Method) bool {
 	rpc := getModifiers(m)
 	return len(rpc.GetRespondsWith()) > 0
 }
 
-func (tql *gengraphql) isMutation(pm pgs.Method) bool {
+func (tql *gengraphql) isFederated(f pgs.File) bool {
+	return getSchemaOptions(f).GetFederated()
+}
+
+func (tql *gengraphql) isSkipped(pm pgs.Method) bool {
 	val := getModifiers(pm)
-	return val.GetMutation()
+	return val.GetSkip()
 }
 
-func (tql *gengraphql) isFederated(f pgs.File) bool {
+func getSchemaOptions(f pgs.File) *options.Schema {
 	opts := f.Descriptor().GetOptions()
 	if proto.HasExtension(opts, options.E_Schema) {
-		mut, err := proto.GetExtension(opts, options.E_Schema)
+		schema, err := proto.GetExtension(opts, options.E_Schema)
 		must(err)
-		val, ok := mut.(*options.Schema)
+		val, ok := schema.(*options.Schema)
 		if !ok {
-			panic(fmt.Sprintf("invalid mutation type: %T\n", mut))
+			panic(fmt.Sprintf("invalid schema type: %T\n", schema))
 		}
-		return val.GetFederated()
+		return val
 	}
-	return false
-}
-
-func (tql *gengraphql) isSkipped(pm pgs.Method) bool {
-	val := getModifiers(pm)
-	return val.GetSkip()
+	return nil
 }
 
 func getModifiers(pm pgs.Method) *options.RPC {
@@ -548,6 +845,9 @@ func (tql *gengraphql) setType(msg pgs.Message) {
 		return
 	}
 	if _, ok := tql.types[typeName]; ok {
+		if tql.connectionTypes[typeName] {
+			panic(fmt.Sprintf("%v collides with the connection type of the same name", msg.FullyQualifiedName()))
+		}
 		return
 	}
 	var i serviceType
@@ -557,6 +857,8 @@ func (tql *gengraphql) setType(msg pgs.Message) {
 	tql.setGraphQLType(i.Name, msg)
 	i.Fields = tql.getFields(msg.NonOneOfFields(), true)
 	i.Fields = append(i.Fields, tql.getUnionFields(msg)...)
+	tql.setResolvedFields(&i, msg)
+	tql.setFederationDirectives(&i, msg)
 }
 
 func (tql *gengraphql) getUnionFields(msg pgs.Message) []*serviceField {
@@ -574,9 +876,8 @@ func (tql *gengraphql) getUnionFields(msg pgs.Message) []*serviceField {
 			Name:  unionName,
 			Types: unionTypes,
 		}
-		importpath := tql.destimportpath + "/" + tql.destpkgname
 		tql.gqlTypes[unionName] = gqlconfig.TypeMapEntry{
-			Model: gqlconfig.StringList{importpath + "." + "unionMask"},
+			Model: gqlconfig.StringList{tql.pkgImportPath(tql.modelDir) + "." + tql.unionMask()},
 		}
 		var sf serviceField
 		sf.Name = oo.Name().String()
@@ -666,9 +967,11 @@ func (tql *gengraphql) setInput(msg pgs.Message) {
 }
 
 // getInputName returns exactly the name of the message declaration:
-// message SomeMessage {
-//   ... fields
-// }
+//
+//	message SomeMessage {
+//	  ... fields
+//	}
+//
 // would return SomeMessage. However, if SomeMessage was also
 // used as an Output and not just Input, then GraphQL will
 // not allow an Input and a Type to be the same name, therefore
@@ -698,27 +1001,26 @@ func (tql *gengraphql) setGraphQLType(name string, msg pgs.Message) {
 
 // deduceImportPath takes a protobuf message and does its best
 // to tell you what the Go import path is for that message.
-// At first, it checks if the go_package option is the same
-// as the current working directory, if that's the case
-// we already called "go list" and we just return tql.modname.
-// Second, if the import path contains one or more "/" chars,
-// then we return exactly the go_package option because this
-// could mean the import path is somewhere outside of the .proto
-// file such as "google.protobuf.Timestamp" pointing to
+// At first, if the go_package option is an import path,
+// then we return exactly that because this could mean the
+// import path is somewhere outside of the .proto file such
+// as "google.protobuf.Timestamp" pointing to
 // "github.com/golang/protobuf/ptypes/timestamp".
+// Second, it checks if the .proto file is in the current
+// working directory, if that's the case we already know the
+// import path and we just return tql.modname.
 // Last, assume the location of the .proto file is in a
-// subdirectory from within the project, so we just call
-// "go list" from within that subdirectory.
+// subdirectory from within the project, so we resolve the
+// import path of that subdirectory from its Go module.
 func (tql *gengraphql) deduceImportPath(msg pgs.Entity) string {
-	gopkg := tql.ctx.ImportPath(msg.File()).String()
-	if gopkg == "." {
-		return tql.modname
-	}
-	if strings.Contains(gopkg, "/") {
+	if gopkg, ok := goPackage(msg.File()); ok {
 		return gopkg
 	}
-
-	return tql.goList(msg.File().InputPath().Dir().String())
+	dir := msg.File().InputPath().Dir().String()
+	if dir == "." {
+		return tql.modname
+	}
+	return tql.importPath(dir)
 }
 
 func (tql *gengraphql) setEnum(protoEnum pgs.Enum) {
@@ -739,6 +1041,7 @@ func (tql *gengraphql) setEnum(protoEnum pgs.Enum) {
 		ImportPath:  tql.deduceImportPath(protoEnum),
 		PackageName: tql.ctx.PackageName(protoEnum.File()).String(),
 		Values:      vals,
+		Closed:      tql.enumDescriptor(protoEnum).IsClosed(),
 	}
 	tql.setGraphQLEnum(name, protoEnum)
 }
@@ -754,7 +1057,7 @@ func (tql *gengraphql) setGraphQLEnum(name string, enum pgs.Enum) {
 func (tql *gengraphql) setBytes(fieldName string, f pgs.Field) {
 	tql.maps["ProtoBytes"] = tql.ctx.Type(f).Value().String()
 	tql.gqlTypes["ProtoBytes"] = gqlconfig.TypeMapEntry{
-		Model: gqlconfig.StringList{tql.destimportpath + "/gengraphql." + "ProtoBytes"},
+		Model: gqlconfig.StringList{tql.pkgImportPath(tql.modelDir) + "." + "ProtoBytes"},
 	}
 }
 
@@ -785,7 +1088,7 @@ func (tql *gengraphql) setMap(fieldName string, f pgs.Field) {
 		tql.maps[upField] = tql.ctx.Type(f).Value().String()
 	}
 	tql.gqlTypes[upField] = gqlconfig.TypeMapEntry{
-		Model: gqlconfig.StringList{tql.destimportpath + "/gengraphql." + upField},
+		Model: gqlconfig.StringList{tql.pkgImportPath(tql.modelDir) + "." + upField},
 	}
 }
 
@@ -807,10 +1110,23 @@ func (tql *gengraphql) getFields(protoFields []pgs.Field, isType bool) []*servic
 }
 
 func (tql *gengraphql) writeUnionMask() {
-	f, err := os.Create(filepath.Join(tql.destpkgname, "unions.gen.go"))
+	f, err := os.Create(tql.pkgPath(tql.modelDir, "unions.gen.go"))
+	must(err)
+	defer f.Close()
+	err = genunions.Render(tql.pkgName(tql.modelDir), tql.unionMask(), f)
+	must(err)
+}
+
+func (tql *gengraphql) writeConnections() {
+	f, err := os.Create(tql.pkgPath(tql.resolverDir, "connections.gen.go"))
 	must(err)
 	defer f.Close()
-	err = genunions.Render(f)
+	modelImportPath, modelPkg := tql.packagePaths(tql.modelDir, tql.resolverDir)
+	err = genconnections.Render(&genconnections.Data{
+		PackageName:     tql.pkgName(tql.resolverDir),
+		ModelImportPath: modelImportPath,
+		ModelPkg:        modelPkg,
+	}, f)
 	must(err)
 }
 
@@ -851,6 +1167,10 @@ func (tql *gengraphql) getField(pf pgs.Field, isType bool) *serviceField {
 	case 12:
 		tmp = "ProtoBytes"
 		tql.setBytes(tmp, pf)
+	// Edition 2023 fields with message_encoding = DELIMITED are
+	// described as messages, so that only proto2 groups get here.
+	case 10:
+		panic(fmt.Sprintf("%v: proto2 groups are not supported, use a nested message instead", pf.FullyQualifiedName()))
 	default:
 		tmp = protoTypesToGqlTypes[pt.String()]
 		if tmp == "" {
@@ -861,9 +1181,45 @@ func (tql *gengraphql) getField(pf pgs.Field, isType bool) *serviceField {
 		tmp = fmt.Sprintf("[%v]", tmp)
 	}
 	f.Type = tmp
+	// proto3 fields keep their non-null types, while proto2 and
+	// editions fields follow their resolved field_presence feature.
+	if pf.Syntax() != pgs.Proto3 {
+		fd := tql.fieldDescriptor(pf)
+		f.Required = fd.Cardinality() == protoreflect.Required
+		f.Optional = fd.HasPresence() && !f.Required && fd.ContainingOneof() == nil
+		if !isType {
+			f.Default = getDefaultValue(pf)
+		}
+	}
 	return &f
 }
 
+// getDefaultValue returns the GraphQL literal of a proto2
+// field's [default = ...] option so that it can be used as
+// an input's default value. Defaults that have no GraphQL
+// representation, such as bytes or inf/nan, are skipped.
+func getDefaultValue(pf pgs.Field) string {
+	desc := pf.Descriptor()
+	if desc.DefaultValue == nil {
+		return ""
+	}
+	val := desc.GetDefaultValue()
+	switch pf.Type().ProtoType().Proto() {
+	// TODO: no magic numbers
+	case 9:
+		bts, err := json.Marshal(val)
+		must(err)
+		return string(bts)
+	case 12:
+		return ""
+	case 1, 2:
+		if val == "inf" || val == "-inf" || val == "nan" {
+			return ""
+		}
+	}
+	return val
+}
+
 // formatQueryInput returns a template-formatted representation
 // of a query input. In GraphQL a query looks like this:
 // `someQuery(req: Request): Response`
@@ -879,6 +1235,82 @@ func (tql *gengraphql) path(s string) string {
 	return filepath.Join(tql.destpkgname, s)
 }
 
+// pkgPath returns the path of a file in the package of
+// the generated Go code whose directory is dir, relative
+// to destpkgname, such as tql.execDir.
+func (tql *gengraphql) pkgPath(dir, s string) string {
+	return filepath.Join(tql.destpkgname, dir, s)
+}
+
+// packageDir returns the directory of a package parameter,
+// which must be inside of output_path.
+func (tql *gengraphql) packageDir(param, def string) string {
+	dir := filepath.Clean(tql.Parameters().StrDefault(param, def))
+	if filepath.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, ".."+string(filepath.Separator)) {
+		panic(fmt.Sprintf("%v must be a directory relative to output_path, got: %q", param, dir))
+	}
+	return dir
+}
+
+// pkgName returns the name of the package of the generated
+// Go code in dir, which is tql.pkgname for destpkgname.
+func (tql *gengraphql) pkgName(dir string) string {
+	if dir == "." {
+		return tql.pkgname
+	}
+	return packageName(dir)
+}
+
+// pkgImportPath returns the import path of the
+// package of the generated Go code in dir.
+func (tql *gengraphql) pkgImportPath(dir string) string {
+	p := filepath.ToSlash(filepath.Join(tql.destpkgname, dir))
+	if p == "." {
+		return tql.destimportpath
+	}
+	return tql.destimportpath + "/" + p
+}
+
+// splitPackages reports whether the executor, resolvers
+// and models are generated into different packages.
+func (tql *gengraphql) splitPackages() bool {
+	return tql.execDir != tql.modelDir || tql.resolverDir != tql.modelDir
+}
+
+// unionMask is the Go type of unions and interfaces, which
+// is exported when the models are in a package of their own.
+func (tql *gengraphql) unionMask() string {
+	if tql.splitPackages() {
+		return "UnionMask"
+	}
+	return "unionMask"
+}
+
+// packagePaths returns the import path and package name of
+// the package in dir, as seen from the package in from, which
+// are empty when they are the same package.
+func (tql *gengraphql) packagePaths(dir, from string) (string, string) {
+	if dir == from {
+		return "", ""
+	}
+	return tql.pkgImportPath(dir), tql.pkgName(dir)
+}
+
+// packageName returns the name of the Go package in
+// dir, its base name with the characters that are not
+// allowed in an identifier replaced by underscores.
+func packageName(dir string) string {
+	abs, err := filepath.Abs(dir)
+	must(err)
+	name := []rune(filepath.Base(abs))
+	for i, r := range name {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			name[i] = '_'
+		}
+	}
+	return string(name)
+}
+
 var protoTypesToGqlTypes = map[string]string{
 	"TYPE_DOUBLE":  "Float",
 	"TYPE_FLOAT":   "Float",
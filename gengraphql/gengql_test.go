@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"flag"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -53,6 +54,93 @@ func TestGQLConfig(t *testing.T) {
 	}
 }
 
+func TestSDL(t *testing.T) {
+	dirs, err := ioutil.ReadDir("testdata")
+	require.NoError(t, err)
+	for _, dir := range dirs {
+		t.Run(dir.Name(), func(t *testing.T) {
+			m, f := getModule(t, dir.Name())
+			m.generateSchema(f, ioutil.Discard)
+			if m.sdl == "" {
+				t.Skip("schema is not federated")
+			}
+			if *update {
+				writeGoldenSDL(t, []byte(m.sdl), dir.Name())
+				return
+			}
+			given := readGoldenSDL(t, dir.Name())
+			require.Equal(t, given, m.sdl)
+		})
+	}
+}
+
+func TestTypeScript(t *testing.T) {
+	dirs, err := ioutil.ReadDir("testdata")
+	require.NoError(t, err)
+	for _, dir := range dirs {
+		t.Run(dir.Name(), func(t *testing.T) {
+			m, f := getModule(t, dir.Name())
+			m.generateSchema(f, ioutil.Discard)
+			var ts, ops bytes.Buffer
+			m.renderTypeScript(&ts)
+			m.renderOperations(&ops)
+			if *update {
+				writeGolden(t, ts.Bytes(), dir.Name(), "schema.ts.golden")
+				writeGolden(t, ops.Bytes(), dir.Name(), "operations.graphql.golden")
+				return
+			}
+			require.Equal(t, readGolden(t, dir.Name(), "schema.ts.golden"), ts.String())
+			require.Equal(t, readGolden(t, dir.Name(), "operations.graphql.golden"), ops.String())
+		})
+	}
+}
+
+func TestIntrospection(t *testing.T) {
+	dirs, err := ioutil.ReadDir("testdata")
+	require.NoError(t, err)
+	for _, dir := range dirs {
+		t.Run(dir.Name(), func(t *testing.T) {
+			m, f := getModule(t, dir.Name())
+			m.generateSchema(f, ioutil.Discard)
+			var bts bytes.Buffer
+			m.renderIntrospection(&bts)
+			if *update {
+				writeGolden(t, bts.Bytes(), dir.Name(), "schema.json.golden")
+				return
+			}
+			require.Equal(t, readGolden(t, dir.Name(), "schema.json.golden"), bts.String())
+		})
+	}
+}
+
+func TestDestImportPath(t *testing.T) {
+	for params, expected := range map[string]string{
+		"":                           "github.com/tmc/protoc-gen-graphql/gengraphql",
+		"importpath=example.com/api": "example.com/api",
+	} {
+		m, f := getModule(t, "gopackage")
+		m.InitContext(pgs.Context(pgs.InitMockDebugger(), pgs.ParseParameters(params), "."))
+		m.setImportPath(".", f)
+		m.setDestImportPath(".")
+		require.Equal(t, expected, m.destimportpath, params)
+		m.generateSchema(f, ioutil.Discard)
+		var bts bytes.Buffer
+		m.touchConfig(&bts)
+		require.Contains(t, bts.String(), "    - "+expected+"/gengraphql.ProtoBytes\n", params)
+	}
+
+	// Outside of a Go module, the go_package is all there is.
+	m, f := getModule(t, "gopackage")
+	m.InitContext(pgs.Context(pgs.InitMockDebugger(), pgs.ParseParameters(""), "."))
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Chdir(wd) })
+	require.NoError(t, os.Chdir(t.TempDir()))
+	m.setImportPath(".", f)
+	m.setDestImportPath(".")
+	require.Equal(t, "github.com/acme/gen/foopb", m.destimportpath)
+}
+
 func getModule(t *testing.T, dirName string) (*gengraphql, pgs.File) {
 	t.Helper()
 	ast := buildGraph(t, dirName)
@@ -62,6 +150,7 @@ func getModule(t *testing.T, dirName string) (*gengraphql, pgs.File) {
 	m.ctx = ctx
 	m.svc = f.Services()[0]
 	m.protopkg = f.Package()
+	m.pkgs = ast.Packages()
 	return m, f
 }
 
@@ -103,6 +192,40 @@ func readGoldenSchema(t *testing.T, dir ...string) string {
 	return string(data)
 }
 
+func writeGoldenSDL(t *testing.T, bts []byte, dir ...string) {
+	t.Helper()
+	dirs := append(append([]string{"testdata"}, dir...), "sdl.graphql.golden")
+	filename := filepath.Join(dirs...)
+	err := ioutil.WriteFile(filename, bts, 0660)
+	require.NoError(t, err)
+}
+
+func readGoldenSDL(t *testing.T, dir ...string) string {
+	t.Helper()
+	dirs := append(append([]string{"testdata"}, dir...), "sdl.graphql.golden")
+	filename := filepath.Join(dirs...)
+
+	data, err := ioutil.ReadFile(filename)
+	require.NoError(t, err, "unable to read CDR at %q", filename)
+
+	return string(data)
+}
+
+func writeGolden(t *testing.T, bts []byte, dir, name string) {
+	t.Helper()
+	filename := filepath.Join("testdata", dir, name)
+	err := ioutil.WriteFile(filename, bts, 0660)
+	require.NoError(t, err)
+}
+
+func readGolden(t *testing.T, dir, name string) string {
+	t.Helper()
+	filename := filepath.Join("testdata", dir, name)
+	data, err := ioutil.ReadFile(filename)
+	require.NoError(t, err, "unable to read golden file at %q", filename)
+	return string(data)
+}
+
 func readCodeGenReq(t *testing.T, dir ...string) *plugin_go.CodeGeneratorRequest {
 	t.Helper()
 	dirs := append(append([]string{"testdata"}, dir...), "code_generator_request.pb.bin")
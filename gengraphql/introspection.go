@@ -0,0 +1,193 @@
+package gengraphql
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/99designs/gqlgen/graphql/introspection"
+)
+
+// The introspection types mirror the fields of the standard
+// introspection query, see introspection.Query, so that the
+// file holds the data of its response.
+type (
+	introspectionResult struct {
+		Schema *introspectionSchema `json:"__schema"`
+	}
+
+	introspectionSchema struct {
+		QueryType        *introspectionTypeName    `json:"queryType"`
+		MutationType     *introspectionTypeName    `json:"mutationType"`
+		SubscriptionType *introspectionTypeName    `json:"subscriptionType"`
+		Types            []*introspectionType      `json:"types"`
+		Directives       []*introspectionDirective `json:"directives"`
+	}
+
+	introspectionTypeName struct {
+		Name *string `json:"name"`
+	}
+
+	introspectionType struct {
+		Kind          string                    `json:"kind"`
+		Name          *string                   `json:"name"`
+		Description   string                    `json:"description"`
+		Fields        []*introspectionField     `json:"fields"`
+		InputFields   []*introspectionInput     `json:"inputFields"`
+		Interfaces    []*introspectionTypeRef   `json:"interfaces"`
+		EnumValues    []*introspectionEnumValue `json:"enumValues"`
+		PossibleTypes []*introspectionTypeRef   `json:"possibleTypes"`
+	}
+
+	introspectionField struct {
+		Name              string                `json:"name"`
+		Description       string                `json:"description"`
+		Args              []*introspectionInput `json:"args"`
+		Type              *introspectionTypeRef `json:"type"`
+		IsDeprecated      bool                  `json:"isDeprecated"`
+		DeprecationReason *string               `json:"deprecationReason"`
+	}
+
+	introspectionInput struct {
+		Name         string                `json:"name"`
+		Description  string                `json:"description"`
+		Type         *introspectionTypeRef `json:"type"`
+		DefaultValue *string               `json:"defaultValue"`
+	}
+
+	introspectionEnumValue struct {
+		Name              string  `json:"name"`
+		Description       string  `json:"description"`
+		IsDeprecated      bool    `json:"isDeprecated"`
+		DeprecationReason *string `json:"deprecationReason"`
+	}
+
+	introspectionTypeRef struct {
+		Kind   string                `json:"kind"`
+		Name   *string               `json:"name"`
+		OfType *introspectionTypeRef `json:"ofType"`
+	}
+
+	introspectionDirective struct {
+		Name        string                `json:"name"`
+		Description string                `json:"description"`
+		Locations   []string              `json:"locations"`
+		Args        []*introspectionInput `json:"args"`
+	}
+)
+
+// writeIntrospection writes the introspection
+// result of the schema next to schema.graphql.
+func (tql *gengraphql) writeIntrospection() {
+	f, err := os.Create(tql.path("schema.json"))
+	must(err)
+	defer f.Close()
+	tql.renderIntrospection(f)
+}
+
+// renderIntrospection writes the result of the standard introspection
+// query against the printed schema and the extra_schema files, the
+// same data that the Handler responds with, with the types and
+// directives sorted by name.
+func (tql *gengraphql) renderIntrospection(out io.Writer) {
+	s := introspection.WrapSchema(tql.servedSchema())
+	result := &introspectionSchema{
+		QueryType:        introspectTypeName(s.QueryType()),
+		MutationType:     introspectTypeName(s.MutationType()),
+		SubscriptionType: introspectTypeName(s.SubscriptionType()),
+		Types:            []*introspectionType{},
+		Directives:       []*introspectionDirective{},
+	}
+	for _, t := range s.Types() {
+		result.Types = append(result.Types, introspectType(&t))
+	}
+	sort.Slice(result.Types, func(i, j int) bool {
+		return *result.Types[i].Name < *result.Types[j].Name
+	})
+	for _, d := range s.Directives() {
+		result.Directives = append(result.Directives, &introspectionDirective{
+			Name:        d.Name,
+			Description: d.Description,
+			Locations:   d.Locations,
+			Args:        introspectInputs(d.Args),
+		})
+	}
+	sort.Slice(result.Directives, func(i, j int) bool {
+		return result.Directives[i].Name < result.Directives[j].Name
+	})
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	must(enc.Encode(&introspectionResult{Schema: result}))
+}
+
+func introspectTypeName(t *introspection.Type) *introspectionTypeName {
+	if t == nil {
+		return nil
+	}
+	return &introspectionTypeName{Name: t.Name()}
+}
+
+func introspectType(t *introspection.Type) *introspectionType {
+	// Like the Handler, lists that do not apply
+	// to the kind of the type are left empty.
+	it := &introspectionType{
+		Kind:          t.Kind(),
+		Name:          t.Name(),
+		Description:   t.Description(),
+		Fields:        []*introspectionField{},
+		InputFields:   introspectInputs(t.InputFields()),
+		Interfaces:    []*introspectionTypeRef{},
+		EnumValues:    []*introspectionEnumValue{},
+		PossibleTypes: []*introspectionTypeRef{},
+	}
+	for _, f := range t.Fields(true) {
+		it.Fields = append(it.Fields, &introspectionField{
+			Name:              f.Name,
+			Description:       f.Description,
+			Args:              introspectInputs(f.Args),
+			Type:              introspectTypeRef(f.Type),
+			IsDeprecated:      f.IsDeprecated(),
+			DeprecationReason: f.DeprecationReason(),
+		})
+	}
+	for _, i := range t.Interfaces() {
+		it.Interfaces = append(it.Interfaces, introspectTypeRef(&i))
+	}
+	for _, v := range t.EnumValues(true) {
+		it.EnumValues = append(it.EnumValues, &introspectionEnumValue{
+			Name:              v.Name,
+			Description:       v.Description,
+			IsDeprecated:      v.IsDeprecated(),
+			DeprecationReason: v.DeprecationReason(),
+		})
+	}
+	for _, p := range t.PossibleTypes() {
+		it.PossibleTypes = append(it.PossibleTypes, introspectTypeRef(&p))
+	}
+	return it
+}
+
+func introspectInputs(inputs []introspection.InputValue) []*introspectionInput {
+	res := []*introspectionInput{}
+	for _, i := range inputs {
+		res = append(res, &introspectionInput{
+			Name:         i.Name,
+			Description:  i.Description,
+			Type:         introspectTypeRef(i.Type),
+			DefaultValue: i.DefaultValue,
+		})
+	}
+	return res
+}
+
+func introspectTypeRef(t *introspection.Type) *introspectionTypeRef {
+	if t == nil {
+		return nil
+	}
+	return &introspectionTypeRef{
+		Kind:   t.Kind(),
+		Name:   t.Name(),
+		OfType: introspectTypeRef(t.OfType()),
+	}
+}
@@ -0,0 +1,100 @@
+package gengraphql
+
+import (
+	"fmt"
+	"os"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/tmc/protoc-gen-graphql/internal/genloaders"
+)
+
+// getLoader returns the DataLoader of an RPC marked with
+// (gengraphql.options.rpc).batch, or nil for other RPCs.
+func (tql *gengraphql) getLoader(pm pgs.Method) *genloaders.Loader {
+	if !getModifiers(pm).GetBatch() {
+		return nil
+	}
+	name := pm.Name().UpperCamelCase().String()
+	if l, ok := tql.loaders[name]; ok {
+		return l
+	}
+	var keys, items pgs.Field
+	for _, f := range pm.Input().NonOneOfFields() {
+		if f.Type().IsRepeated() && !f.Type().Element().IsEmbed() {
+			if keys != nil {
+				keys = nil
+				break
+			}
+			keys = f
+		}
+	}
+	for _, f := range pm.Output().NonOneOfFields() {
+		if f.Type().IsRepeated() && f.Type().Element().IsEmbed() {
+			if items != nil {
+				items = nil
+				break
+			}
+			items = f
+		}
+	}
+	if keys == nil || items == nil || keys.Type().Element().IsEnum() {
+		panic(fmt.Sprintf(
+			"%v: batch RPCs must have a single repeated scalar request field "+
+				"and a single repeated message response field",
+			pm.FullyQualifiedName(),
+		))
+	}
+	// Bytes keys are slices, which can't be the
+	// keys of the map that the DataLoader caches by.
+	if keys.Type().Element().ProtoType() == pgs.BytesT {
+		panic(fmt.Sprintf(
+			"%v: the keys of batch RPCs can't be bytes",
+			pm.FullyQualifiedName(),
+		))
+	}
+	item := items.Type().Element().Embed()
+	l := &genloaders.Loader{
+		Name:              name,
+		RequestImportPath: tql.deduceImportPath(pm.Input()),
+		RequestPkg:        tql.ctx.PackageName(pm.Input()).String(),
+		RequestType:       tql.ctx.Name(pm.Input()).String(),
+		KeysField:         tql.ctx.Name(keys).String(),
+		KeyType:           tql.ctx.Type(keys).Element().String(),
+		ItemImportPath:    tql.deduceImportPath(item),
+		ItemPkg:           tql.ctx.PackageName(item).String(),
+		ItemType:          tql.ctx.Name(item).String(),
+		ItemsField:        tql.ctx.Name(items).String(),
+	}
+	tql.loaders[name] = l
+	return l
+}
+
+// getLoaderItem returns the message type
+// that the DataLoader of an RPC returns.
+func getLoaderItem(pm pgs.Method) pgs.Message {
+	for _, f := range pm.Output().NonOneOfFields() {
+		if f.Type().IsRepeated() && f.Type().Element().IsEmbed() {
+			return f.Type().Element().Embed()
+		}
+	}
+	return nil
+}
+
+func (tql *gengraphql) writeLoaders() {
+	f, err := os.Create(tql.pkgPath(tql.resolverDir, "loaders.gen.go"))
+	must(err)
+	defer f.Close()
+	importPath, pkg := tql.servicePackage()
+	data := &genloaders.Data{
+		PackageName:       tql.pkgName(tql.resolverDir),
+		ServiceImportPath: importPath,
+		ServicePkg:        pkg,
+		ServiceName:       tql.serviceType(),
+	}
+	for _, pm := range tql.svc.Methods() {
+		if l := tql.getLoader(pm); l != nil {
+			data.Loaders = append(data.Loaders, l)
+		}
+	}
+	must(genloaders.Render(data, f))
+}
@@ -0,0 +1,35 @@
+package gengraphql
+
+import (
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoaderKeys(t *testing.T) {
+	ast := buildGraph(t, "resolve", "batchkeys")
+	f := ast.Targets()["batchkeys.proto"]
+	m := New("batchkeys").(*gengraphql)
+	m.ctx = pgsgo.InitContext(pgs.ParseParameters(""))
+	m.svc = f.Services()[0]
+	m.protopkg = f.Package()
+	m.pkgs = ast.Packages()
+	for _, tc := range []struct {
+		rpc, msg string
+	}{
+		{"BatchGetByTokens", "batchkeys.Service.BatchGetByTokens: the keys of batch RPCs can't be bytes"},
+		{"BatchGetByKinds", "batchkeys.Service.BatchGetByKinds: batch RPCs must have a single repeated scalar request field"},
+		{"BatchGetByIds", "<nil>"},
+	} {
+		t.Run(tc.rpc, func(t *testing.T) {
+			for _, pm := range m.svc.Methods() {
+				if pm.Name().String() == tc.rpc {
+					require.Contains(t, catch(func() { m.getLoader(pm) }), tc.msg)
+				}
+			}
+		})
+	}
+	require.Equal(t, "string", m.loaders["BatchGetByIds"].KeyType)
+}
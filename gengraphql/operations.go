@@ -0,0 +1,161 @@
+package gengraphql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/vektah/gqlparser/v2/ast"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// httpExtension is the field number of the google.api.http
+// method option, which descriptors built without the
+// annotations package linked in keep as an unknown field.
+const httpExtension = 72295728
+
+// mutationPrefixes are the method name prefixes of the
+// AIP-133 Create, AIP-134 Update and AIP-135 Delete methods
+// and of their AIP-233 to AIP-235 batch forms. AIP-231
+// BatchGet methods only read resources and stay queries.
+var mutationPrefixes = []string{"Create", "Update", "Delete", "BatchCreate", "BatchUpdate", "BatchDelete"}
+
+func (tql *gengraphql) isMutation(pm pgs.Method) bool {
+	val := getModifiers(pm)
+	if (val != nil && val.Mutation != nil) || !tql.infersOperations(pm.File()) {
+		return val.GetMutation()
+	}
+	if verb, ok := getHTTPVerb(pm); ok {
+		return verb != "get"
+	}
+	name := pm.Name().UpperCamelCase().String()
+	for _, prefix := range mutationPrefixes {
+		if hasWordPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// rootField returns the operation type of an RPC,
+// "query" or "mutation", along with its root field
+// in the parsed schema.
+func (tql *gengraphql) rootField(pm pgs.Method) (string, *ast.FieldDefinition) {
+	name := pm.Name().LowerCamelCase().String()
+	if tql.isMutation(pm) {
+		return "mutation", tql.schema.Mutation.Fields.ForName(name)
+	}
+	return "query", tql.schema.Query.Fields.ForName(name)
+}
+
+// fieldArguments returns the variable definitions of an operation
+// that calls the given field, and the arguments that pass each of
+// those variables to the field, such as "($req: HelloReq)" and
+// "(req: $req)". Both are empty when the field takes no arguments.
+func fieldArguments(field *ast.FieldDefinition) (string, string) {
+	if len(field.Arguments) == 0 {
+		return "", ""
+	}
+	vars := []string{}
+	args := []string{}
+	for _, arg := range field.Arguments {
+		vars = append(vars, fmt.Sprintf("$%v: %v", arg.Name, arg.Type))
+		args = append(args, fmt.Sprintf("%v: $%v", arg.Name, arg.Name))
+	}
+	return "(" + strings.Join(vars, ", ") + ")", "(" + strings.Join(args, ", ") + ")"
+}
+
+func (tql *gengraphql) infersOperations(f pgs.File) bool {
+	return tql.inferOperations || getSchemaOptions(f).GetInferOperations()
+}
+
+// getHTTPVerb returns the lower case HTTP verb
+// of the google.api.http binding of an RPC.
+func getHTTPVerb(pm pgs.Method) (string, bool) {
+	rule := getHTTPRule(pm.Descriptor().GetOptions())
+	if rule == nil {
+		return "", false
+	}
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return "get", true
+	case *annotations.HttpRule_Put:
+		return "put", true
+	case *annotations.HttpRule_Post:
+		return "post", true
+	case *annotations.HttpRule_Delete:
+		return "delete", true
+	case *annotations.HttpRule_Patch:
+		return "patch", true
+	case *annotations.HttpRule_Custom:
+		return strings.ToLower(pattern.Custom.GetKind()), true
+	}
+	return "", false
+}
+
+// getHTTPRule returns the google.api.http option of an RPC, or nil.
+func getHTTPRule(opts *descriptor.MethodOptions) *annotations.HttpRule {
+	if opts == nil {
+		return nil
+	}
+	if proto.HasExtension(opts, annotations.E_Http) {
+		ext, err := proto.GetExtension(opts, annotations.E_Http)
+		must(err)
+		rule, ok := ext.(*annotations.HttpRule)
+		if !ok {
+			panic(fmt.Sprintf("invalid google.api.http type: %T", ext))
+		}
+		return rule
+	}
+	bts, ok := findBytesField(opts.ProtoReflect().GetUnknown(), httpExtension)
+	if !ok {
+		return nil
+	}
+	rule := &annotations.HttpRule{}
+	if err := proto.Unmarshal(bts, rule); err != nil {
+		panic(fmt.Sprintf("invalid google.api.http option: %v", err))
+	}
+	return rule
+}
+
+// findBytesField returns the last length-delimited
+// field with the given number in a wire encoded message.
+func findBytesField(b []byte, num protowire.Number) ([]byte, bool) {
+	var val []byte
+	var found bool
+	for len(b) > 0 {
+		n, typ, tagLen := protowire.ConsumeTag(b)
+		if tagLen < 0 {
+			return nil, false
+		}
+		b = b[tagLen:]
+		if n == num && typ == protowire.BytesType {
+			v, valLen := protowire.ConsumeBytes(b)
+			if valLen < 0 {
+				return nil, false
+			}
+			val, found = v, true
+		}
+		valLen := protowire.ConsumeFieldValue(n, typ, b)
+		if valLen < 0 {
+			return nil, false
+		}
+		b = b[valLen:]
+	}
+	return val, found
+}
+
+// hasWordPrefix reports whether prefix is the first
+// word of the UpperCamelCase name, so that Deleted
+// does not count as Delete.
+func hasWordPrefix(name, prefix string) bool {
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	rest := name[len(prefix):]
+	return rest == "" || unicode.IsUpper(rune(rest[0])) || unicode.IsDigit(rune(rest[0]))
+}
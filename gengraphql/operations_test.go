@@ -0,0 +1,50 @@
+package gengraphql
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestHTTPRule(t *testing.T) {
+	opts := &descriptor.MethodOptions{}
+	require.NoError(t, proto.SetExtension(opts, annotations.E_Http, &annotations.HttpRule{
+		Pattern: &annotations.HttpRule_Custom{Custom: &annotations.CustomHttpPattern{Kind: "PURGE", Path: "/v1/books"}},
+	}))
+	require.Equal(t, "PURGE", getHTTPRule(opts).GetCustom().GetKind())
+
+	// Options decoded without google.api.http in the
+	// registry keep the binding as an unknown field.
+	bts, err := protov2.Marshal(opts)
+	require.NoError(t, err)
+	unknown := &descriptor.MethodOptions{}
+	require.NoError(t, protov2.UnmarshalOptions{Resolver: &protoregistry.Types{}}.Unmarshal(bts, unknown))
+	require.NotEmpty(t, unknown.ProtoReflect().GetUnknown())
+	require.Equal(t, "PURGE", getHTTPRule(unknown).GetCustom().GetKind())
+
+	require.Nil(t, getHTTPRule(&descriptor.MethodOptions{}))
+	require.Nil(t, getHTTPRule(nil))
+}
+
+func TestMutationPrefixes(t *testing.T) {
+	for name, mutation := range map[string]bool{
+		"CreateBook":       true,
+		"BatchCreateBooks": true,
+		"BatchUpdateBooks": true,
+		"BatchDeleteBooks": true,
+		"BatchGetBooks":    false,
+		"Batch":            false,
+		"Updates":          false,
+	} {
+		got := false
+		for _, prefix := range mutationPrefixes {
+			got = got || hasWordPrefix(name, prefix)
+		}
+		require.Equal(t, mutation, got, name)
+	}
+}
@@ -0,0 +1,110 @@
+package gengraphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gqlconfig "github.com/99designs/gqlgen/codegen/config"
+	"github.com/golang/protobuf/proto"
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/tmc/protoc-gen-graphql/gengraphql/options"
+	"github.com/tmc/protoc-gen-graphql/internal/genresolver"
+)
+
+// setResolvedFields adds the fields of a type that are resolved
+// by calling another RPC, as declared by (gengraphql.options.field).resolve.
+// A message field is dropped from the type when the resolved
+// field takes its name.
+func (tql *gengraphql) setResolvedFields(t *serviceType, msg pgs.Message) {
+	for _, pf := range msg.Fields() {
+		resolve := getFieldOptions(pf).GetResolve()
+		if resolve == nil {
+			continue
+		}
+		pm := tql.getResolveMethod(pf, resolve)
+		name := resolve.GetName()
+		if name == "" {
+			name = strings.TrimSuffix(pf.Name().String(), "_id")
+		}
+		fieldResolver := &genresolver.FieldResolver{
+			RPC:               pm.Name().UpperCamelCase().String(),
+			RequestImportPath: tql.deduceImportPath(pm.Input()),
+			RequestType:       tql.ctx.Name(pm.Input()).String(),
+			Args:              []*genresolver.FieldResolverArg{},
+		}
+		output := pm.Output()
+		if tql.getLoader(pm) != nil {
+			if len(resolve.GetArgs()) != 1 {
+				panic(fmt.Sprintf("%v: fields resolved through batch RPCs take a single key argument", pf.FullyQualifiedName()))
+			}
+			fieldResolver.Loader = true
+			output = getLoaderItem(pm)
+		}
+		argNames := []string{}
+		for arg := range resolve.GetArgs() {
+			argNames = append(argNames, arg)
+		}
+		sort.Strings(argNames)
+		for _, arg := range argNames {
+			reqField := getFieldByName(pm.Input(), arg)
+			if reqField == nil {
+				panic(fmt.Sprintf("%v: %v has no field %v", pf.FullyQualifiedName(), pm.Input().Name(), arg))
+			}
+			objField := getFieldByName(msg, resolve.GetArgs()[arg])
+			if objField == nil {
+				panic(fmt.Sprintf("%v: %v has no field %v", pf.FullyQualifiedName(), msg.Name(), resolve.GetArgs()[arg]))
+			}
+			fieldResolver.Args = append(fieldResolver.Args, &genresolver.FieldResolverArg{
+				RequestField: tql.ctx.Name(reqField).String(),
+				ObjectField:  tql.ctx.Name(objField).String(),
+			})
+		}
+		tql.setType(output)
+		typeName, _ := tql.getQualifiedName(output)
+		fields := []*serviceField{}
+		for _, f := range t.Fields {
+			if f.Name != name {
+				fields = append(fields, f)
+			}
+		}
+		f := &serviceField{Name: name, Type: typeName, Optional: true}
+		if len(fields) < len(t.Fields) {
+			f.Doc = pf.SourceCodeInfo().LeadingComments()
+		}
+		t.Fields = append(fields, f)
+		tql.fieldResolvers[t.Name+"."+name] = fieldResolver
+		entry := tql.gqlTypes[t.Name]
+		if entry.Fields == nil {
+			entry.Fields = map[string]gqlconfig.TypeMapField{}
+		}
+		entry.Fields[name] = gqlconfig.TypeMapField{Resolver: true}
+		tql.gqlTypes[t.Name] = entry
+	}
+}
+
+func (tql *gengraphql) getResolveMethod(pf pgs.Field, resolve *options.Resolve) pgs.Method {
+	for _, pm := range tql.svc.Methods() {
+		if pm.Name().String() == resolve.GetRpc() {
+			return pm
+		}
+	}
+	panic(fmt.Sprintf(
+		"%v: resolve rpc %v is not defined in service %v; only RPCs of the same service can be resolved",
+		pf.FullyQualifiedName(), resolve.GetRpc(), tql.svc.Name(),
+	))
+}
+
+func getFieldOptions(pf pgs.Field) *options.Field {
+	opts := pf.Descriptor().GetOptions()
+	if proto.HasExtension(opts, options.E_Field) {
+		field, err := proto.GetExtension(opts, options.E_Field)
+		must(err)
+		val, ok := field.(*options.Field)
+		if !ok {
+			panic(fmt.Sprintf("invalid field type: %T\n", field))
+		}
+		return val
+	}
+	return nil
+}
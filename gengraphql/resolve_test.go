@@ -0,0 +1,22 @@
+package gengraphql
+
+import (
+	"io/ioutil"
+	"testing"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveOtherService(t *testing.T) {
+	ast := buildGraph(t, "resolve", "crossservice")
+	f := ast.Targets()["crossservice.proto"]
+	m := New("crossservice").(*gengraphql)
+	m.ctx = pgsgo.InitContext(pgs.ParseParameters(""))
+	m.svc = f.Services()[0]
+	m.protopkg = f.Package()
+	m.pkgs = ast.Packages()
+	msg := catch(func() { m.generateSchema(f, ioutil.Discard) })
+	require.Contains(t, msg, "crossservice.Order.customer_id: resolve rpc GetCustomer is not defined in service Service; only RPCs of the same service")
+}
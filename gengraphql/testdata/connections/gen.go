@@ -0,0 +1,3 @@
+package connections
+
+//go:generate protoc -I . -I ../../options -I /usr/local/include --debug_out=.:. connections.proto
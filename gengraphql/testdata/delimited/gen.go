@@ -0,0 +1,3 @@
+package delimited
+
+//go:generate protoc --debug_out=.:. delimited.proto
@@ -0,0 +1,3 @@
+package editions
+
+//go:generate protoc --debug_out=.:. editions.proto
@@ -0,0 +1,3 @@
+package errors
+
+//go:generate protoc -I . -I ../../options -I /usr/local/include --debug_out=.:. errors.proto
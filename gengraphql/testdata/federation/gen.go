@@ -0,0 +1,3 @@
+package federation
+
+//go:generate protoc -I . -I ../../options -I /usr/local/include --debug_out=.:. federation.proto
@@ -0,0 +1,3 @@
+package collision
+
+//go:generate protoc -I . -I ../../../options -I /usr/local/include --debug_out=.:. collision.proto
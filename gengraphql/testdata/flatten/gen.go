@@ -0,0 +1,3 @@
+package flatten
+
+//go:generate protoc -I . -I ../../options -I /usr/local/include --debug_out=.:. flatten.proto
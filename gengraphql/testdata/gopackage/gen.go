@@ -0,0 +1,3 @@
+package gopackage
+
+//go:generate protoc --debug_out=.:. gopackage.proto
@@ -0,0 +1,3 @@
+package infer
+
+//go:generate protoc -I . -I ../../options -I /usr/local/include --debug_out=.:. infer.proto
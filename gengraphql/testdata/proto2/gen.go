@@ -0,0 +1,3 @@
+package proto2
+
+//go:generate protoc --debug_out=.:. proto2.proto
@@ -0,0 +1,3 @@
+package proto2import
+
+//go:generate protoc --debug_out=.:. proto2import.proto
@@ -0,0 +1,3 @@
+package batchkeys
+
+//go:generate protoc -I . -I ../../../options -I /usr/local/include --debug_out=.:. batchkeys.proto
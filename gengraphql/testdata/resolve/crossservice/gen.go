@@ -0,0 +1,3 @@
+package crossservice
+
+//go:generate protoc -I . -I ../../../options -I /usr/local/include --debug_out=.:. crossservice.proto
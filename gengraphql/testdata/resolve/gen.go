@@ -0,0 +1,3 @@
+package resolve
+
+//go:generate protoc -I . -I ../../options -I /usr/local/include --debug_out=.:. resolve.proto
@@ -0,0 +1,3 @@
+package subgraph
+
+//go:generate protoc -I . -I ../../options -I /usr/local/include --debug_out=.:. subgraph.proto
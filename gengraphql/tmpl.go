@@ -64,22 +64,31 @@ type Mutation { {{ range .Service.Mutations }}
 
 {{ range .Types }}
 {{ fmtDoc .Doc }}
-type {{ .Name }} {
+type {{ .Name }}{{ with .Implements }} implements {{ join " & " . }}{{ end }}{{ .Directives }} {
 {{ range .Fields }}
     {{- fmtDoc .Doc "    " }}
-    {{ .Name }}: {{ .Type }}!
+    {{ .Name }}: {{ .Type }}{{ if not .Optional }}!{{ end }}{{ .Directives }}
 {{ end }}
     {{- if (eq (len .Fields) 0) }}
     responseMessage: String!
     {{- end }}
 }
 {{ end }}
+{{ range .Interfaces }}
+{{ fmtDoc .Doc }}
+interface {{ .Name }} {
+{{ range .Fields }}
+    {{- fmtDoc .Doc "    " }}
+    {{ .Name }}: {{ .Type }}{{ if not .Optional }}!{{ end }}
+{{ end }}
+}
+{{ end }}
 {{ range .Inputs }}
 {{ fmtDoc .Doc }}
 input {{ .Name }} {
 {{ range .Fields }}
     {{- fmtDoc .Doc "    " }}
-    {{ .Name }}: {{ .Type }}
+    {{ .Name }}: {{ .Type }}{{ if .Required }}!{{ end }}{{ with .Default }} = {{ . }}{{ end }}
 {{ end }}
 {{- if (eq (len .Fields) 0) }}
     # This is being place because the number of fields would be zero otherwise. This is a bug in the generator.
@@ -99,4 +108,7 @@ scalar {{ . }}
 {{ range .Unions }}
 union {{ .Name }} = {{ fmtUnions .Types }}
 {{ end }}
+{{ range .Directives }}
+{{ . }}
+{{ end }}
 `
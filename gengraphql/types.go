@@ -1,12 +1,14 @@
 package gengraphql
 
 type file struct {
-	Service *service // TODO: multiple services
-	Types   []*serviceType
-	Inputs  []*serviceType
-	Enums   []*enums
-	Scalars []string
-	Unions  []*union // TODO:
+	Service    *service // TODO: multiple services
+	Types      []*serviceType
+	Interfaces []*serviceType
+	Inputs     []*serviceType
+	Enums      []*enums
+	Scalars    []string
+	Unions     []*union // TODO:
+	Directives []string
 }
 
 type service struct {
@@ -24,12 +26,37 @@ type serviceType struct {
 	Name   string
 	Fields []*serviceField
 	Doc    string
+
+	// Directives are applied to the type,
+	// such as the @key of a federated entity.
+	Directives string
+
+	// Implements are the interfaces of the type,
+	// such as the Error interface of error types.
+	Implements []string
 }
 
 type serviceField struct {
 	Name string
 	Type string
 	Doc  string
+
+	// Optional marks a type field as nullable,
+	// such as proto2 optional fields which may
+	// not be set in the response.
+	Optional bool
+
+	// Required marks an input field as non-null,
+	// such as proto2 required fields.
+	Required bool
+
+	// Default is the GraphQL literal of
+	// a proto2 [default = ...] option.
+	Default string
+
+	// Directives are applied to a type
+	// field, such as @external.
+	Directives string
 }
 
 type method struct {
@@ -0,0 +1,184 @@
+package gengraphql
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// tsScalars are the TypeScript types
+// of the built-in GraphQL scalars.
+var tsScalars = map[string]string{
+	"Int":     "number",
+	"Float":   "number",
+	"String":  "string",
+	"Boolean": "boolean",
+	"ID":      "string",
+}
+
+// writeTypeScript writes the TypeScript types of the schema
+// and a document with an operation for each of its RPCs. The
+// types and fields of the extra_schema files are left out,
+// since they are resolved by hand rather than by the RPCs.
+func (tql *gengraphql) writeTypeScript() {
+	f, err := os.Create(tql.path("schema.ts"))
+	must(err)
+	defer f.Close()
+	tql.renderTypeScript(f)
+	ops, err := os.Create(tql.path("operations.graphql"))
+	must(err)
+	defer ops.Close()
+	tql.renderOperations(ops)
+}
+
+// renderTypeScript writes an interface for each type and input of the
+// schema, an enum for each enum, and a type alias for each union
+// and scalar. Map scalars are JSON objects in responses, while inputs
+// take them, as well as ProtoBytes, as JSON encoded strings.
+func (tql *gengraphql) renderTypeScript(out io.Writer) {
+	funcs := tmplFuncs()
+	funcs["tsDoc"] = tsDoc
+	funcs["tsType"] = tql.tsType
+	funcs["tsScalar"] = tql.tsScalar
+	tmpl := template.Must(template.New("").Funcs(funcs).Parse(typescriptTemplate))
+	must(tmpl.Execute(out, tql.gqlFile))
+}
+
+// tsType returns the TypeScript type of a
+// field's GraphQL type, such as "[String]".
+func (tql *gengraphql) tsType(typ string, input bool) string {
+	if strings.HasPrefix(typ, "[") {
+		elem := strings.TrimSuffix(strings.TrimPrefix(typ, "["), "]")
+		return "Array<" + tql.tsType(elem, input) + " | null>"
+	}
+	if t, ok := tsScalars[typ]; ok {
+		return t
+	}
+	if _, ok := tql.maps[typ]; ok && input {
+		return "string"
+	}
+	return typ
+}
+
+// tsScalar returns the TypeScript type
+// of a scalar that the schema declares.
+func (tql *gengraphql) tsScalar(name string) string {
+	switch name {
+	case "ProtoBytes", "FieldSet":
+		return "string"
+	}
+	return "Record<string, unknown>"
+}
+
+// tsDoc returns the documentation comment of a declaration.
+func tsDoc(description string, indent string) string {
+	trimmed := strings.TrimSpace(description)
+	if trimmed == "" {
+		return ""
+	}
+	lines := []string{indent + "/**"}
+	for _, l := range strings.Split(trimmed, "\n") {
+		lines = append(lines, strings.TrimRight(indent+" * "+strings.TrimSpace(l), " "))
+	}
+	lines = append(lines, indent+" */")
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// renderOperations writes a GraphQL document with the query or
+// mutation that calls each RPC, selecting every field of its
+// response down to tql.tsDepth levels of nested objects.
+func (tql *gengraphql) renderOperations(out io.Writer) {
+	var b strings.Builder
+	b.WriteString("# Code was generated by github.com/tmc/protoc-gen-graphql. DO NOT EDIT.\n")
+	for _, pm := range tql.svc.Methods() {
+		if tql.isSkipped(pm) {
+			continue
+		}
+		operation, field := tql.rootField(pm)
+		vars, args := fieldArguments(field)
+		fmt.Fprintf(&b, "\n%v %v%v {\n  %v%v %v\n}\n",
+			operation, pm.Name().UpperCamelCase(), vars, field.Name, args,
+			tql.selectFields(field.Type.Name(), tql.tsDepth, "  "))
+	}
+	if _, err := gqlparser.LoadQuery(tql.schema, b.String()); err != nil {
+		panic(err)
+	}
+	_, err := io.WriteString(out, b.String())
+	must(err)
+}
+
+// selectFields returns the selection set of a type with each of
+// its fields, down to depth levels of nested objects. Unions and
+// interfaces select the fields of each of their possible types.
+func (tql *gengraphql) selectFields(typeName string, depth int, indent string) string {
+	def := tql.schema.Types[typeName]
+	selections := []string{}
+	switch def.Kind {
+	case ast.Union, ast.Interface:
+		selections = append(selections, "__typename")
+		for _, t := range tql.schema.GetPossibleTypes(def) {
+			selections = append(selections, "... on "+t.Name+" "+tql.selectFields(t.Name, depth, indent+"  "))
+		}
+	default:
+		for _, f := range def.Fields {
+			if tql.schema.Types[f.Type.Name()].IsLeafType() {
+				selections = append(selections, f.Name)
+			} else if depth > 1 {
+				selections = append(selections, f.Name+" "+tql.selectFields(f.Type.Name(), depth-1, indent+"  "))
+			}
+		}
+		if len(selections) == 0 {
+			selections = append(selections, "__typename")
+		}
+	}
+	return "{\n" + indent + "  " + strings.Join(selections, "\n"+indent+"  ") + "\n" + indent + "}"
+}
+
+const typescriptTemplate = `// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+{{ range .Scalars }}
+export type {{ . }} = {{ tsScalar . }};
+{{ end }}
+{{- range .Enums }}
+{{ tsDoc .Doc "" }}export enum {{ .Name }} {
+{{- range .Fields }}
+{{ tsDoc .Doc "  " }}  {{ .Name }} = "{{ .Name }}",
+{{- end }}
+}
+{{ end }}
+{{- range .Interfaces }}
+{{ tsDoc .Doc "" }}export interface {{ .Name }} {
+{{- range .Fields }}
+{{ tsDoc .Doc "  " }}  {{ .Name }}{{ if .Optional }}?{{ end }}: {{ tsType .Type false }}{{ if .Optional }} | null{{ end }};
+{{- end }}
+}
+{{ end }}
+{{- range .Types }}
+{{ tsDoc .Doc "" }}export interface {{ .Name }}{{ with .Implements }} extends {{ join ", " . }}{{ end }} {
+  __typename?: "{{ .Name }}";
+{{- range .Fields }}
+{{ tsDoc .Doc "  " }}  {{ .Name }}{{ if .Optional }}?{{ end }}: {{ tsType .Type false }}{{ if .Optional }} | null{{ end }};
+{{- end }}
+{{- if (eq (len .Fields) 0) }}
+  responseMessage: string;
+{{- end }}
+}
+{{ end }}
+{{- range .Inputs }}
+{{ tsDoc .Doc "" }}export interface {{ .Name }} {
+{{- range .Fields }}
+{{ tsDoc .Doc "  " }}  {{ .Name }}{{ if not .Required }}?{{ end }}: {{ tsType .Type true }}{{ if not .Required }} | null{{ end }};
+{{- end }}
+{{- if (eq (len .Fields) 0) }}
+  invalidInput: string;
+{{- end }}
+}
+{{ end }}
+{{- range .Unions }}
+export type {{ .Name }} = {{ join " | " .Types }};
+{{ end -}}
+`
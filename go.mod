@@ -1,34 +1,49 @@
 module github.com/tmc/protoc-gen-graphql
 
-go 1.14
+go 1.21
 
 require (
+	connectrpc.com/connect v1.16.1
 	github.com/99designs/gqlgen v0.12.2
+	github.com/Masterminds/sprig v2.22.0+incompatible
+	github.com/davecgh/go-spew v1.1.1
+	github.com/golang/protobuf v1.5.4
+	github.com/lyft/protoc-gen-star v0.4.14
+	github.com/stretchr/testify v1.5.1
+	github.com/twitchtv/twirp v5.10.1+incompatible
+	github.com/vektah/gqlparser/v2 v2.0.1
+	google.golang.org/genproto/googleapis/api v0.0.0-20240318140521-94a12d6c2237
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237
+	google.golang.org/grpc v1.64.0
+	google.golang.org/protobuf v1.34.2
+	gopkg.in/yaml.v2 v2.3.0
+)
+
+require (
 	github.com/Masterminds/goutils v1.1.0 // indirect
 	github.com/Masterminds/semver v1.5.0 // indirect
-	github.com/Masterminds/sprig v2.22.0+incompatible
 	github.com/agnivade/levenshtein v1.1.0 // indirect
 	github.com/cpuguy83/go-md2man/v2 v2.0.0 // indirect
-	github.com/davecgh/go-spew v1.1.1
-	github.com/golang/protobuf v1.4.1
-	github.com/google/uuid v1.1.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/gorilla/websocket v1.4.2 // indirect
 	github.com/hashicorp/golang-lru v0.5.4 // indirect
 	github.com/huandu/xstrings v1.3.2 // indirect
 	github.com/imdario/mergo v0.3.11 // indirect
-	github.com/lyft/protoc-gen-star v0.4.14
 	github.com/matryer/moq v0.0.0-20200816112511-720d53e65d2f // indirect
 	github.com/mitchellh/copystructure v1.0.0 // indirect
 	github.com/mitchellh/mapstructure v1.3.3 // indirect
+	github.com/mitchellh/reflectwalk v1.0.0 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/spf13/afero v1.2.2 // indirect
-	github.com/stretchr/testify v1.5.1
-	github.com/twitchtv/twirp v5.10.1+incompatible
 	github.com/urfave/cli/v2 v2.2.0 // indirect
 	github.com/vektah/dataloaden v0.3.0 // indirect
-	github.com/vektah/gqlparser/v2 v2.0.1
-	golang.org/x/tools v0.0.0-20200831203904-5a2aa26beb65 // indirect
-	google.golang.org/protobuf v1.25.0
-	gopkg.in/yaml.v2 v2.3.0
+	golang.org/x/crypto v0.21.0 // indirect
+	golang.org/x/mod v0.8.0 // indirect
+	golang.org/x/net v0.22.0 // indirect
+	golang.org/x/sys v0.18.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	golang.org/x/tools v0.6.0 // indirect
 )
 
 replace github.com/99designs/gqlgen => github.com/tmc/gqlgen v0.0.0-20200901050952-6383e6ad1368
@@ -0,0 +1,213 @@
+package breaking
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Load parses the schema in the given file.
+func Load(filename string) (*ast.Schema, error) {
+	bts, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{
+		Name:  filename,
+		Input: string(bts),
+	})
+	if gqlErr != nil {
+		return nil, gqlErr
+	}
+	return schema, nil
+}
+
+// Compare returns the changes from the old to the new schema that
+// break the clients of the old one: removed and renamed types,
+// removed fields, arguments, enum values and union members, output
+// fields that turned nullable and inputs that turned required.
+func Compare(old, updated *ast.Schema) []string {
+	changes := []string{}
+	names := []string{}
+	for name, def := range old.Types {
+		if !def.BuiltIn {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		oldDef, newDef := old.Types[name], updated.Types[name]
+		if newDef == nil || newDef.Kind != oldDef.Kind {
+			changes = append(changes, removed(old, updated, oldDef))
+			continue
+		}
+		switch oldDef.Kind {
+		case ast.Object, ast.Interface:
+			changes = append(changes, compareFields(oldDef, newDef)...)
+		case ast.InputObject:
+			changes = append(changes, compareInputFields(oldDef, newDef)...)
+		case ast.Enum:
+			for _, v := range oldDef.EnumValues {
+				if newDef.EnumValues.ForName(v.Name) == nil {
+					changes = append(changes, fmt.Sprintf("enum value %v.%v was removed", name, v.Name))
+				}
+			}
+		case ast.Union:
+			for _, t := range oldDef.Types {
+				if !contains(newDef.Types, t) {
+					changes = append(changes, fmt.Sprintf("union member %v.%v was removed", name, t))
+				}
+			}
+		}
+	}
+	return changes
+}
+
+// removed describes a type that is gone from the new schema, or
+// that became another kind of type. It is renamed when the new
+// schema adds a type of the same kind with the same fields or
+// values, such as an input renamed to XxxInput because its
+// message became a type too.
+func removed(old, updated *ast.Schema, def *ast.Definition) string {
+	members := memberNames(def)
+	names := []string{}
+	for name, t := range updated.Types {
+		if old.Types[name] == nil || old.Types[name].Kind != t.Kind {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t := updated.Types[name]
+		if t.Kind == def.Kind && name != def.Name && members != "" && memberNames(t) == members {
+			return fmt.Sprintf("%v %v was renamed to %v", kindName(def.Kind), def.Name, name)
+		}
+	}
+	if t := updated.Types[def.Name]; t != nil {
+		return fmt.Sprintf("%v %v became %v %v", kindName(def.Kind), def.Name, kindName(t.Kind), def.Name)
+	}
+	return fmt.Sprintf("%v %v was removed", kindName(def.Kind), def.Name)
+}
+
+func compareFields(old, updated *ast.Definition) []string {
+	changes := []string{}
+	for _, f := range old.Fields {
+		if strings.HasPrefix(f.Name, "__") {
+			continue
+		}
+		nf := updated.Fields.ForName(f.Name)
+		if nf == nil {
+			changes = append(changes, fmt.Sprintf("field %v.%v was removed", old.Name, f.Name))
+			continue
+		}
+		if outputBreaks(f.Type, nf.Type) {
+			changes = append(changes, fmt.Sprintf("field %v.%v changed type from %v to %v", old.Name, f.Name, f.Type, nf.Type))
+		}
+		for _, arg := range f.Arguments {
+			na := nf.Arguments.ForName(arg.Name)
+			if na == nil {
+				changes = append(changes, fmt.Sprintf("argument %v.%v(%v) was removed", old.Name, f.Name, arg.Name))
+			} else if inputBreaks(arg.Type, na.Type) {
+				changes = append(changes, fmt.Sprintf("argument %v.%v(%v) changed type from %v to %v", old.Name, f.Name, arg.Name, arg.Type, na.Type))
+			}
+		}
+		for _, na := range nf.Arguments {
+			if f.Arguments.ForName(na.Name) == nil && isRequired(na.Type, na.DefaultValue) {
+				changes = append(changes, fmt.Sprintf("required argument %v.%v(%v) was added", old.Name, f.Name, na.Name))
+			}
+		}
+	}
+	return changes
+}
+
+func compareInputFields(old, updated *ast.Definition) []string {
+	changes := []string{}
+	for _, f := range old.Fields {
+		nf := updated.Fields.ForName(f.Name)
+		if nf == nil {
+			changes = append(changes, fmt.Sprintf("input field %v.%v was removed", old.Name, f.Name))
+		} else if inputBreaks(f.Type, nf.Type) {
+			changes = append(changes, fmt.Sprintf("input field %v.%v changed type from %v to %v", old.Name, f.Name, f.Type, nf.Type))
+		}
+	}
+	for _, nf := range updated.Fields {
+		if old.Fields.ForName(nf.Name) == nil && isRequired(nf.Type, nf.DefaultValue) {
+			changes = append(changes, fmt.Sprintf("required input field %v.%v was added", old.Name, nf.Name))
+		}
+	}
+	return changes
+}
+
+// outputBreaks reports whether the values of a field of type typ
+// can no longer be read as old: a non-null type became nullable,
+// or the named type or the nesting of lists changed.
+func outputBreaks(old, typ *ast.Type) bool {
+	if old.NonNull && !typ.NonNull {
+		return true
+	}
+	if (old.Elem == nil) != (typ.Elem == nil) {
+		return true
+	}
+	if old.Elem != nil {
+		return outputBreaks(old.Elem, typ.Elem)
+	}
+	return old.NamedType != typ.NamedType
+}
+
+// inputBreaks reports whether the values that clients pass as old
+// are no longer valid as typ: a nullable type became non-null,
+// or the named type or the nesting of lists changed.
+func inputBreaks(old, typ *ast.Type) bool {
+	if !old.NonNull && typ.NonNull {
+		return true
+	}
+	if (old.Elem == nil) != (typ.Elem == nil) {
+		return true
+	}
+	if old.Elem != nil {
+		return inputBreaks(old.Elem, typ.Elem)
+	}
+	return old.NamedType != typ.NamedType
+}
+
+func isRequired(typ *ast.Type, defaultValue *ast.Value) bool {
+	return typ.NonNull && defaultValue == nil
+}
+
+// memberNames returns the sorted names of the fields,
+// enum values or union members of a type.
+func memberNames(def *ast.Definition) string {
+	names := []string{}
+	for _, f := range def.Fields {
+		names = append(names, f.Name)
+	}
+	for _, v := range def.EnumValues {
+		names = append(names, v.Name)
+	}
+	names = append(names, def.Types...)
+	sort.Strings(names)
+	return strings.Join(names, " ")
+}
+
+func kindName(kind ast.DefinitionKind) string {
+	switch kind {
+	case ast.Object:
+		return "type"
+	case ast.InputObject:
+		return "input"
+	}
+	return strings.ToLower(string(kind))
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
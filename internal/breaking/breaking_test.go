@@ -0,0 +1,132 @@
+package breaking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestCompare(t *testing.T) {
+	old := loadSchema(t, `
+type Query {
+	getBook(req: Book): BookResp!
+	listBooks(shelf: String): [BookResp]
+}
+
+input Book {
+	title: String
+	genre: Genre
+}
+
+type BookResp {
+	title: String!
+	author: String!
+	tags: [String]
+}
+
+enum Genre {
+	FICTION
+	POETRY
+}
+
+union Result = BookResp | Other
+
+type Other {
+	name: String!
+}
+`)
+	updated := loadSchema(t, `
+type Query {
+	getBook(req: BookInput, id: String!): Book!
+	listBooks(shelf: String!, first: Int): [Book]
+}
+
+input BookInput {
+	title: String
+	genre: Genre
+}
+
+type Book {
+	title: String!
+	tags: [String]
+}
+
+type BookResp {
+	title: String
+	tags: String
+	pages: Int
+}
+
+enum Genre {
+	FICTION
+}
+
+union Result = BookResp
+
+type Other {
+	name: String!
+}
+`)
+	require.Equal(t, []string{
+		"input Book was renamed to BookInput",
+		"field BookResp.title changed type from String! to String",
+		"field BookResp.author was removed",
+		"field BookResp.tags changed type from [String] to String",
+		"enum value Genre.POETRY was removed",
+		"field Query.getBook changed type from BookResp! to Book!",
+		"argument Query.getBook(req) changed type from Book to BookInput",
+		"required argument Query.getBook(id) was added",
+		"field Query.listBooks changed type from [BookResp] to [Book]",
+		"argument Query.listBooks(shelf) changed type from String to String!",
+		"union member Result.Other was removed",
+	}, Compare(old, updated))
+}
+
+func TestCompareInputs(t *testing.T) {
+	old := loadSchema(t, `
+type Query {
+	getBook(req: Req): String
+}
+
+input Req {
+	id: String!
+	name: String
+	tags: [String]
+}
+`)
+	updated := loadSchema(t, `
+type Query {
+	getBook(req: Req): String!
+}
+
+input Req {
+	id: String
+	name: String!
+	shelf: String!
+	limit: Int! = 10
+}
+`)
+	require.Equal(t, []string{
+		"input field Req.name changed type from String to String!",
+		"input field Req.tags was removed",
+		"required input field Req.shelf was added",
+	}, Compare(old, updated))
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	sdl := `
+type Query {
+	hello(name: String): String!
+}
+`
+	require.Empty(t, Compare(loadSchema(t, sdl), loadSchema(t, sdl)))
+}
+
+func loadSchema(t *testing.T, sdl string) *ast.Schema {
+	t.Helper()
+	schema, err := gqlparser.LoadSchema(&ast.Source{Input: sdl})
+	require.Nil(t, err)
+	return schema
+}
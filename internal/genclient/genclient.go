@@ -0,0 +1,103 @@
+package genclient
+
+import (
+	"bytes"
+	"go/format"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+var tmpl = template.Must(template.New("genclient").Funcs(template.FuncMap{
+	"lcFirst": func(s string) string {
+		return strings.ToLower(s[:1]) + s[1:]
+	},
+}).Parse(tmplStr))
+
+// Data is what's needed to render the Go
+// client of a service's GraphQL schema.
+type Data struct {
+	PackageName string
+	ServiceName string
+	Methods     []*Method
+}
+
+// Method is a Query or Mutation field
+// that the client calls with an RPC's
+// request and response messages.
+type Method struct {
+	// Name is the Go name of the RPC.
+	Name string
+	// Field is the name of the root field.
+	Field string
+	// Operation is either "query" or "mutation".
+	Operation string
+	// Document is the GraphQL document of the operation
+	// with a %s verb in place of the field's selection set.
+	Document string
+	// Selection is the default selection set,
+	// which holds every field of the response.
+	Selection string
+	// Input is set when the field takes the request as
+	// its req argument, and Flattened when it takes
+	// each request field as an argument of its own.
+	Input     bool
+	Flattened bool
+
+	RequestImportPath string
+	RequestPkg        string
+	RequestType       string
+
+	ResponseImportPath string
+	ResponsePkg        string
+	ResponseType       string
+
+	// Errors are the responds_with types of the RPC.
+	Errors []*ErrorType
+}
+
+// ErrorType is a message that an operation
+// returns in place of its response.
+type ErrorType struct {
+	// Name is the GraphQL name of the type.
+	Name       string
+	ImportPath string
+	Pkg        string
+	Type       string
+}
+
+type final struct {
+	*Data
+	Imports []string
+}
+
+// Render renders a Client with a method for each of the
+// given operations, along with the code that converts
+// protobuf messages from and to GraphQL values.
+func Render(data *Data, out io.Writer) error {
+	mp := map[string]struct{}{}
+	for _, m := range data.Methods {
+		mp[m.RequestImportPath] = struct{}{}
+		mp[m.ResponseImportPath] = struct{}{}
+		for _, e := range m.Errors {
+			mp[e.ImportPath] = struct{}{}
+		}
+	}
+	final := &final{Data: data}
+	for k := range mp {
+		final.Imports = append(final.Imports, k)
+	}
+	sort.Strings(final.Imports)
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, final)
+	if err != nil {
+		return err
+	}
+	bts, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, bytes.NewReader(bts))
+	return err
+}
@@ -0,0 +1,65 @@
+package genclient
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite all golden files")
+
+func TestGenClient(t *testing.T) {
+	d := &Data{
+		PackageName: "client",
+		ServiceName: "Library",
+		Methods: []*Method{{
+			Name:               "GetBook",
+			Field:              "getBook",
+			Operation:          "query",
+			Document:           "query GetBook($req: GetBookReq) { getBook(req: $req) %s }",
+			Selection:          "{ title genre }",
+			Input:              true,
+			RequestImportPath:  "pkg.go/library",
+			RequestPkg:         "library",
+			RequestType:        "GetBookReq",
+			ResponseImportPath: "pkg.go/library",
+			ResponsePkg:        "library",
+			ResponseType:       "Book",
+		}, {
+			Name:               "CreateBook",
+			Field:              "createBook",
+			Operation:          "mutation",
+			Document:           "mutation CreateBook($title: String) { createBook(title: $title) %s }",
+			Selection:          "{ __typename ... on Book { title genre } ... on Errors_BookExists { message } }",
+			Flattened:          true,
+			RequestImportPath:  "pkg.go/library",
+			RequestPkg:         "library",
+			RequestType:        "CreateBookReq",
+			ResponseImportPath: "pkg.go/library",
+			ResponsePkg:        "library",
+			ResponseType:       "Book",
+			Errors: []*ErrorType{{
+				Name:       "Errors_BookExists",
+				ImportPath: "pkg.go/errors",
+				Pkg:        "errors",
+				Type:       "BookExists",
+			}},
+		}},
+	}
+
+	var b bytes.Buffer
+	err := Render(d, &b)
+	require.NoError(t, err)
+
+	if *update {
+		ioutil.WriteFile("testdata/client.golden", b.Bytes(), 0660)
+		return
+	}
+
+	expected, err := ioutil.ReadFile("testdata/client.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), b.String())
+}
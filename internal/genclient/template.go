@@ -0,0 +1,422 @@
+package genclient
+
+const tmplStr = `// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	{{ range .Imports }}
+	"{{.}}"{{ end }}
+)
+
+// Client calls the queries and mutations of the {{.ServiceName}}
+// GraphQL schema with the protobuf messages of its RPCs.
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// New returns a Client of the GraphQL endpoint at url that sends
+// its requests with httpClient, or http.DefaultClient when nil.
+func New(url string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{url: url, httpClient: httpClient}
+}
+
+// CallOption configures a single call of the Client.
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	selection string
+}
+
+// Selection replaces the selection set of a call, which asks
+// for every field of the response message by default. Fields
+// that are left out keep their zero values. For example:
+//
+//	client.GetBook(ctx, req, Selection("{ title }"))
+func Selection(selection string) CallOption {
+	return func(o *callOptions) {
+		o.selection = selection
+	}
+}
+
+// Error is an error of a GraphQL response, whose
+// extensions hold the code of the service error.
+type Error struct {
+	Message    string                 ` + "`" + `json:"message"` + "`" + `
+	Path       []interface{}          ` + "`" + `json:"path,omitempty"` + "`" + `
+	Extensions map[string]interface{} ` + "`" + `json:"extensions,omitempty"` + "`" + `
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Code returns the code extension of the error.
+func (e *Error) Code() string {
+	code, _ := e.Extensions["code"].(string)
+	return code
+}
+
+// Errors are the errors of a GraphQL response.
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// DataError is returned when an operation responds with one
+// of the responds_with error types of its RPC instead of the
+// response. Message holds the error type.
+type DataError struct {
+	Message proto.Message
+}
+
+func (e *DataError) Error() string {
+	if m, ok := e.Message.(interface{ GetMessage() string }); ok {
+		return m.GetMessage()
+	}
+	return string(proto.MessageName(e.Message))
+}
+
+type operation struct {
+	name      string
+	field     string
+	document  string
+	selection string
+	variables func(req proto.Message) map[string]interface{}
+	errors    map[string]func() proto.Message
+}
+{{ range .Methods }}
+var {{lcFirst .Name}}Operation = &operation{
+	name:      {{printf "%q" .Name}},
+	field:     {{printf "%q" .Field}},
+	document:  {{printf "%q" .Document}},
+	selection: {{printf "%q" .Selection}},
+	{{- if .Flattened }}
+	variables: flattenedVariables,
+	{{- else if .Input }}
+	variables: inputVariables,
+	{{- end }}
+	{{- with .Errors }}
+	errors: map[string]func() proto.Message{
+		{{- range . }}
+		{{printf "%q" .Name}}: func() proto.Message { return &{{.Pkg}}.{{.Type}}{} },
+		{{- end }}
+	},
+	{{- end }}
+}
+
+// {{.Name}} calls the {{.Field}} {{.Operation}}.
+func (c *Client) {{.Name}}(ctx context.Context, req *{{.RequestPkg}}.{{.RequestType}}, opts ...CallOption) (*{{.ResponsePkg}}.{{.ResponseType}}, error) {
+	if req == nil {
+		req = &{{.RequestPkg}}.{{.RequestType}}{}
+	}
+	resp := &{{.ResponsePkg}}.{{.ResponseType}}{}
+	if err := c.do(ctx, {{lcFirst .Name}}Operation, req, resp, opts); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+{{ end }}
+func (c *Client) do(ctx context.Context, op *operation, req, resp proto.Message, opts []CallOption) error {
+	o := &callOptions{selection: op.selection}
+	for _, opt := range opts {
+		opt(o)
+	}
+	params := map[string]interface{}{
+		"operationName": op.name,
+		"query":         fmt.Sprintf(op.document, o.selection),
+	}
+	if op.variables != nil {
+		params["variables"] = op.variables(req)
+	}
+	body, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	hresp, err := c.httpClient.Do(hreq)
+	if err != nil {
+		return err
+	}
+	defer hresp.Body.Close()
+	var result struct {
+		Data   map[string]json.RawMessage ` + "`" + `json:"data"` + "`" + `
+		Errors Errors                     ` + "`" + `json:"errors"` + "`" + `
+	}
+	if err := json.NewDecoder(hresp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("graphql: %v: %w", hresp.Status, err)
+	}
+	if len(result.Errors) > 0 {
+		return result.Errors
+	}
+	var data map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(result.Data[op.field]))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil || data == nil {
+		return fmt.Errorf("graphql: %v has no data", op.field)
+	}
+	typename, _ := data["__typename"].(string)
+	if newError, ok := op.errors[typename]; ok {
+		msg := newError()
+		if err := decodeMessage(msg.ProtoReflect(), data); err != nil {
+			return fmt.Errorf("graphql: %v: %w", op.field, err)
+		}
+		return &DataError{Message: msg}
+	}
+	if err := decodeMessage(resp.ProtoReflect(), data); err != nil {
+		return fmt.Errorf("graphql: %v: %w", op.field, err)
+	}
+	return nil
+}
+
+// inputVariables passes the request as the req argument.
+func inputVariables(req proto.Message) map[string]interface{} {
+	return map[string]interface{}{"req": encodeMessage(req.ProtoReflect(), false)}
+}
+
+// flattenedVariables passes each field of
+// the request as an argument of its own.
+func flattenedVariables(req proto.Message) map[string]interface{} {
+	return encodeMessage(req.ProtoReflect(), false)
+}
+
+// encodeMessage returns the input object of a message. Oneofs
+// have no input fields so they are left out. Maps are scalars
+// that hold their JSON encoding, so the values within them are
+// encoded the way encoding/json decodes them instead.
+func encodeMessage(m protoreflect.Message, inMap bool) map[string]interface{} {
+	obj := map[string]interface{}{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+			return true
+		}
+		obj[string(fd.Name())] = encodeField(fd, v, inMap)
+		return true
+	})
+	return obj
+}
+
+func encodeField(fd protoreflect.FieldDescriptor, v protoreflect.Value, inMap bool) interface{} {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		vals := make([]interface{}, list.Len())
+		for i := range vals {
+			vals[i] = encodeValue(fd, list.Get(i), inMap)
+		}
+		return vals
+	case fd.IsMap():
+		obj := map[string]interface{}{}
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			obj[k.String()] = encodeValue(fd.MapValue(), v, true)
+			return true
+		})
+		if inMap {
+			return obj
+		}
+		bts, _ := json.Marshal(obj)
+		return string(bts)
+	}
+	return encodeValue(fd, v, inMap)
+}
+
+func encodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, inMap bool) interface{} {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil && !inMap {
+			return string(ev.Name())
+		}
+		return v.Enum()
+	case protoreflect.BytesKind:
+		if inMap {
+			return v.Bytes()
+		}
+		// ProtoBytes holds the JSON encoding of the bytes.
+		bts, _ := json.Marshal(v.Bytes())
+		return string(bts)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return encodeMessage(v.Message(), inMap)
+	}
+	return v.Interface()
+}
+
+// decodeMessage sets the fields of a message from a GraphQL object.
+// A oneof holds an object of one of its union's member types, whose
+// single field is set on the message itself. Fields that the message
+// does not have, such as the ones resolved by other RPCs, are skipped.
+func decodeMessage(m protoreflect.Message, obj map[string]interface{}) error {
+	desc := m.Descriptor()
+	for name, val := range obj {
+		if val == nil {
+			continue
+		}
+		if fd := desc.Fields().ByName(protoreflect.Name(name)); fd != nil {
+			if err := decodeField(m, fd, val); err != nil {
+				return fmt.Errorf("%v: %w", name, err)
+			}
+			continue
+		}
+		if oo := desc.Oneofs().ByName(protoreflect.Name(name)); oo != nil {
+			member, ok := val.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%v: expected an object, got %T", name, val)
+			}
+			if err := decodeMessage(m, member); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func decodeField(m protoreflect.Message, fd protoreflect.FieldDescriptor, val interface{}) error {
+	switch {
+	case fd.IsList():
+		vals, ok := val.([]interface{})
+		if !ok {
+			return fmt.Errorf("expected a list, got %T", val)
+		}
+		list := m.Mutable(fd).List()
+		for _, val := range vals {
+			if val == nil {
+				continue
+			}
+			v, err := decodeValue(fd, list.NewElement(), val)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	case fd.IsMap():
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("expected an object, got %T", val)
+		}
+		mp := m.Mutable(fd).Map()
+		for key, val := range obj {
+			k, err := decodeValue(fd.MapKey(), protoreflect.Value{}, key)
+			if err != nil {
+				return err
+			}
+			v, err := decodeValue(fd.MapValue(), mp.NewValue(), val)
+			if err != nil {
+				return err
+			}
+			mp.Set(k.MapKey(), v)
+		}
+		return nil
+	}
+	v, err := decodeValue(fd, m.NewField(fd), val)
+	if err != nil {
+		return err
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+// decodeValue returns the value of a single field from a GraphQL
+// value, or from a JSON value within a map. Message values are
+// decoded into v, and numbers may also be held by strings.
+func decodeValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, val interface{}) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return v, fmt.Errorf("expected an object, got %T", val)
+		}
+		return v, decodeMessage(v.Message(), obj)
+	case protoreflect.StringKind:
+		if s, ok := val.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.BytesKind:
+		if s, ok := val.(string); ok {
+			b, err := base64.StdEncoding.DecodeString(s)
+			return protoreflect.ValueOfBytes(b), err
+		}
+	case protoreflect.BoolKind:
+		switch val := val.(type) {
+		case bool:
+			return protoreflect.ValueOfBool(val), nil
+		case string:
+			b, err := strconv.ParseBool(val)
+			return protoreflect.ValueOfBool(b), err
+		}
+	case protoreflect.EnumKind:
+		if s, ok := val.(string); ok {
+			if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+				return protoreflect.ValueOfEnum(ev.Number()), nil
+			}
+		}
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseInt(s, 10, 32)
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err
+		}
+		return v, fmt.Errorf("unknown %v value %v", fd.Enum().FullName(), val)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if s, ok := number(val); ok {
+			f, err := strconv.ParseFloat(s, 64)
+			if fd.Kind() == protoreflect.FloatKind {
+				return protoreflect.ValueOfFloat32(float32(f)), err
+			}
+			return protoreflect.ValueOfFloat64(f), err
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseInt(s, 10, 32)
+			return protoreflect.ValueOfInt32(int32(n)), err
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseInt(s, 10, 64)
+			return protoreflect.ValueOfInt64(n), err
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseUint(s, 10, 32)
+			return protoreflect.ValueOfUint32(uint32(n)), err
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if s, ok := number(val); ok {
+			n, err := strconv.ParseUint(s, 10, 64)
+			return protoreflect.ValueOfUint64(n), err
+		}
+	}
+	return v, fmt.Errorf("cannot use %T as %v", val, fd.Kind())
+}
+
+func number(val interface{}) (string, bool) {
+	switch val := val.(type) {
+	case json.Number:
+		return string(val), true
+	case string:
+		return val, true
+	}
+	return "", false
+}
+`
@@ -0,0 +1,69 @@
+package genconnect
+
+import (
+	"bytes"
+	"go/format"
+	"io"
+	"sort"
+	"text/template"
+)
+
+var tmpl = template.Must(template.New("genconnect").Parse(tmplStr))
+
+// Data is what's needed to adapt the connect
+// client of a service to the generated resolvers.
+type Data struct {
+	// PackageName is the package of connectService.
+	PackageName string
+	// ClientImportPath and ClientPkg are the package of
+	// the connect client, such as libraryconnect.
+	ClientImportPath string
+	ClientPkg        string
+	ServiceName      string
+	Methods          []*Method
+}
+
+// Method describes a unary RPC of the service.
+type Method struct {
+	// Name is the Go name of the RPC.
+	Name string
+
+	RequestImportPath string
+	RequestPkg        string
+	RequestType       string
+
+	ResponseImportPath string
+	ResponsePkg        string
+	ResponseType       string
+}
+
+type final struct {
+	*Data
+	Imports []string
+}
+
+// Render renders connectService, the interface that the resolvers
+// call, along with its implementation over a connect client.
+func Render(data *Data, out io.Writer) error {
+	mp := map[string]struct{}{data.ClientImportPath: {}}
+	for _, m := range data.Methods {
+		mp[m.RequestImportPath] = struct{}{}
+		mp[m.ResponseImportPath] = struct{}{}
+	}
+	final := &final{Data: data}
+	for k := range mp {
+		final.Imports = append(final.Imports, k)
+	}
+	sort.Strings(final.Imports)
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, final)
+	if err != nil {
+		return err
+	}
+	bts, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, bytes.NewReader(bts))
+	return err
+}
@@ -0,0 +1,51 @@
+package genconnect
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite all golden files")
+
+func TestGenConnect(t *testing.T) {
+	d := &Data{
+		PackageName:      "gengraphql",
+		ClientImportPath: "pkg.go/library/libraryconnect",
+		ClientPkg:        "libraryconnect",
+		ServiceName:      "Library",
+		Methods: []*Method{{
+			Name:               "GetBook",
+			RequestImportPath:  "pkg.go/library",
+			RequestPkg:         "library",
+			RequestType:        "GetBookReq",
+			ResponseImportPath: "pkg.go/library",
+			ResponsePkg:        "library",
+			ResponseType:       "Book",
+		}, {
+			Name:               "GetAuthor",
+			RequestImportPath:  "pkg.go/library",
+			RequestPkg:         "library",
+			RequestType:        "GetAuthorReq",
+			ResponseImportPath: "pkg.go/people",
+			ResponsePkg:        "people",
+			ResponseType:       "Author",
+		}},
+	}
+
+	var b bytes.Buffer
+	err := Render(d, &b)
+	require.NoError(t, err)
+
+	if *update {
+		ioutil.WriteFile("testdata/connect.golden", b.Bytes(), 0660)
+		return
+	}
+
+	expected, err := ioutil.ReadFile("testdata/connect.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), b.String())
+}
@@ -0,0 +1,85 @@
+package genconnect
+
+const tmplStr = `// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	{{ range .Imports }}
+	"{{.}}"{{ end }}
+)
+
+// connectService is the {{.ServiceName}} service that the resolvers call.
+type connectService interface {
+	{{- range .Methods }}
+	{{.Name}}(ctx context.Context, req *{{.RequestPkg}}.{{.RequestType}}) (*{{.ResponsePkg}}.{{.ResponseType}}, error)
+	{{- end }}
+}
+
+// connectClient calls the RPCs of a connect client with the messages
+// of the resolvers, forwarding the headers of the GraphQL request.
+type connectClient struct {
+	client {{.ClientPkg}}.{{.ServiceName}}Client
+}
+
+func newConnectService(client {{.ClientPkg}}.{{.ServiceName}}Client) connectService {
+	return connectClient{client}
+}
+{{ range .Methods }}
+func (c connectClient) {{.Name}}(ctx context.Context, req *{{.RequestPkg}}.{{.RequestType}}) (*{{.ResponsePkg}}.{{.ResponseType}}, error) {
+	if req == nil {
+		req = &{{.RequestPkg}}.{{.RequestType}}{}
+	}
+	creq := connect.NewRequest(req)
+	forwardHeaders(ctx, creq.Header())
+	resp, err := c.client.{{.Name}}(ctx, creq)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+{{ end }}
+type headersKey struct{}
+
+// withHeaders returns a context that carries the
+// headers of the GraphQL request to the service.
+func withHeaders(ctx context.Context, h http.Header) context.Context {
+	return context.WithValue(ctx, headersKey{}, h)
+}
+
+// skippedHeaders describe the HTTP request itself, rather
+// than headers that the service should receive.
+var skippedHeaders = map[string]bool{
+	"Accept-Encoding":   true,
+	"Connection":        true,
+	"Content-Length":    true,
+	"Content-Type":      true,
+	"Host":              true,
+	"Keep-Alive":        true,
+	"Proxy-Connection":  true,
+	"Te":                true,
+	"Trailer":           true,
+	"Transfer-Encoding": true,
+	"Upgrade":           true,
+	"User-Agent":        true,
+}
+
+// forwardHeaders adds the headers of the GraphQL request to h.
+func forwardHeaders(ctx context.Context, h http.Header) {
+	src, _ := ctx.Value(headersKey{}).(http.Header)
+	for k, v := range src {
+		k = http.CanonicalHeaderKey(k)
+		if skippedHeaders[k] || strings.HasPrefix(k, "Connect-") || strings.HasPrefix(k, "Grpc-") {
+			continue
+		}
+		for _, val := range v {
+			h.Add(k, val)
+		}
+	}
+}
+`
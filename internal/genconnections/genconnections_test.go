@@ -0,0 +1,40 @@
+package genconnections
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite all golden files")
+
+func TestGenConnections(t *testing.T) {
+	for name, d := range map[string]*Data{
+		"connections.golden": {
+			PackageName: "gengraphql",
+		},
+		"model.golden": {
+			PackageName:     "resolver",
+			ModelImportPath: "pkg.go/library/model",
+			ModelPkg:        "model",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var b bytes.Buffer
+			err := Render(d, &b)
+			require.NoError(t, err)
+
+			if *update {
+				ioutil.WriteFile("testdata/"+name, b.Bytes(), 0660)
+				return
+			}
+
+			expected, err := ioutil.ReadFile("testdata/" + name)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), b.String())
+		})
+	}
+}
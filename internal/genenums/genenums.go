@@ -14,18 +14,26 @@ type Data struct {
 	Pkg        string
 	Name       string
 	GoName     string
+
+	// Closed enums (proto2, or editions with enum_type
+	// = CLOSED) are checked for unknown values, which
+	// they can only hold when converted from an int32
+	// in Go, while open enums keep printing the number
+	// of the values that the GraphQL schema misses.
+	Closed bool
 }
 
 type final struct {
-	Imports []string
-	Enums   []*Data
+	PackageName string
+	Imports     []string
+	Enums       []*Data
 }
 
-// Render extends gqlgen's exectuionContext
-// to map protobuf enums to gql enums
-func Render(data []*Data, out io.Writer) error {
+// Render extends gqlgen's exectuionContext in package
+// pkgName to map protobuf enums to gql enums
+func Render(pkgName string, data []*Data, out io.Writer) error {
 	var b bytes.Buffer
-	final := &final{}
+	final := &final{PackageName: pkgName}
 	mp := map[string]struct{}{}
 	for _, d := range data {
 		mp[d.ImportPath] = struct{}{}
@@ -18,9 +18,18 @@ func TestGenEnums(t *testing.T) {
 		Name:       "one",
 		GoName:     "one",
 	}
+	// Closed enums are checked for unknown values, since
+	// Go lets any int32 be converted to an enum type.
+	closed := &Data{
+		ImportPath: "pkg.go/enums",
+		Pkg:        "enums",
+		Name:       "two",
+		GoName:     "two",
+		Closed:     true,
+	}
 
 	var b bytes.Buffer
-	err := Render([]*Data{d}, &b)
+	err := Render("gengraphql", []*Data{d, closed}, &b)
 	require.NoError(t, err)
 
 	if *update {
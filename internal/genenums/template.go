@@ -4,7 +4,7 @@ import "text/template"
 
 var enumTemplate = template.Must(template.New("").Parse(`// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
 
-package gengraphql
+package {{.PackageName}}
 
 import (
 	"context"
@@ -17,6 +17,12 @@ import (
 )
 {{ range .Enums }}
 func (ec *executionContext) _{{ .Name }}(ctx context.Context, sel ast.SelectionSet, v *{{.Pkg}}.{{.GoName}}) graphql.Marshaler {
+	{{- if .Closed }}
+	if _, ok := {{.Pkg}}.{{.GoName}}_name[int32(*v)]; !ok {
+		ec.Errorf(ctx, "unknown value %d for enum {{ .Name }}", *v)
+		return graphql.Null
+	}
+	{{- end }}
 	return graphql.MarshalString((*v).String())
 }
 
@@ -0,0 +1,28 @@
+package generrors
+
+import (
+	"bytes"
+	"go/format"
+	"io"
+	"text/template"
+)
+
+var tmpl = template.Must(template.New("generrors").Parse(tmplStr))
+
+// Render renders DataError, which services return to respond
+// with an error type of an RPC, and dataErrors, which finds
+// the error messages of an error returned by the given backend,
+// in package pkgName.
+func Render(pkgName, backend string, out io.Writer) error {
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, struct{ PackageName, Backend string }{pkgName, backend})
+	if err != nil {
+		return err
+	}
+	bts, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, bytes.NewReader(bts))
+	return err
+}
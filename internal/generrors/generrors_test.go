@@ -0,0 +1,32 @@
+package generrors
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite all golden files")
+
+func TestGenErrors(t *testing.T) {
+	for _, backend := range []string{"twirp", "grpc", "connect"} {
+		t.Run(backend, func(t *testing.T) {
+			var b bytes.Buffer
+			err := Render("gengraphql", backend, &b)
+			require.NoError(t, err)
+
+			golden := "testdata/" + backend + ".golden"
+			if *update {
+				ioutil.WriteFile(golden, b.Bytes(), 0660)
+				return
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), b.String())
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package generrors
+
+const tmplStr = `// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"errors"
+
+	{{- if eq .Backend "connect" }}
+
+	"connectrpc.com/connect"
+	{{- else if eq .Backend "grpc" }}
+
+	"google.golang.org/grpc/status"
+	{{- end }}
+	"google.golang.org/protobuf/proto"
+)
+
+// DataError is an error of a service that carries one of the
+// error types that an RPC responds_with. Its resolver returns
+// the error type as data, as a member of the RPC's result union,
+// instead of failing with the error. For example:
+//
+//	return nil, {{.PackageName}}.NewDataError(&pb.NotFound{Message: "no such book"})
+type DataError struct {
+	Message proto.Message
+}
+
+// NewDataError returns a DataError that carries msg.
+func NewDataError(msg proto.Message) error {
+	return &DataError{Message: msg}
+}
+
+func (e *DataError) Error() string {
+	if m, ok := e.Message.(interface{ GetMessage() string }); ok {
+		return m.GetMessage()
+	}
+	return string(proto.MessageName(e.Message))
+}
+
+// dataErrors returns the messages that err carries, which are
+// either the message of a DataError
+{{- if eq .Backend "connect" }}, or the details of a connect error.
+{{- else if eq .Backend "grpc" }}, or the details of a gRPC status.
+{{- else }}.
+{{- end }}
+func dataErrors(err error) []proto.Message {
+	if err == nil {
+		return nil
+	}
+	var dataErr *DataError
+	if errors.As(err, &dataErr) {
+		return []proto.Message{dataErr.Message}
+	}
+	{{- if eq .Backend "connect" }}
+	msgs := []proto.Message{}
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		for _, d := range cerr.Details() {
+			if msg, err := d.Value(); err == nil {
+				msgs = append(msgs, msg)
+			}
+		}
+	}
+	return msgs
+	{{- else if eq .Backend "grpc" }}
+	msgs := []proto.Message{}
+	if st, ok := status.FromError(err); ok {
+		for _, d := range st.Details() {
+			if msg, ok := d.(proto.Message); ok {
+				msgs = append(msgs, msg)
+			}
+		}
+	}
+	return msgs
+	{{- else }}
+	return nil
+	{{- end }}
+}
+`
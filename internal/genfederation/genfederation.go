@@ -0,0 +1,108 @@
+package genfederation
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"text/template"
+)
+
+var tmpl = template.Must(template.New("genfederation").Funcs(template.FuncMap{
+	"q": func(s string) string {
+		return fmt.Sprintf("%q", s)
+	},
+}).Parse(tmplStr))
+
+// Data is what's needed to render the _service
+// and _entities fields of a federated subgraph.
+type Data struct {
+	PackageName       string
+	ServiceImportPath string
+	ServicePkg        string
+	ServiceName       string
+	// SDL is the schema that _service returns.
+	SDL      string
+	Entities []*Entity
+
+	// ModelImportPath and ModelPkg are the import path and
+	// name of the package of the union marker, empty when
+	// it is the package of the executor.
+	ModelImportPath string
+	ModelPkg        string
+	// ExternalResolvers is set when the Resolver is declared
+	// in another package than the executor, which then gets
+	// the service from the resolvers that embed it.
+	ExternalResolvers bool
+}
+
+// Entity describes a message with a @key and
+// the RPC that looks it up from a representation.
+type Entity struct {
+	// Name is the GraphQL name of the entity.
+	Name string
+	// RPC is the Go name of the lookup RPC.
+	RPC string
+
+	RequestImportPath string
+	RequestPkg        string
+	RequestType       string
+
+	// Fields maps the proto names of the request
+	// fields to the key fields of a representation.
+	Fields map[string]string
+}
+
+// ServiceType is the Go type of the service, which is declared
+// in the generated package when it has no ServicePkg.
+func (d *Data) ServiceType() string {
+	if d.ServicePkg == "" {
+		return d.ServiceName
+	}
+	return d.ServicePkg + "." + d.ServiceName
+}
+
+// UnionMask is the Go type of the members of the _Entity union.
+func (d *Data) UnionMask() string {
+	if d.ModelPkg == "" {
+		return "unionMask"
+	}
+	return d.ModelPkg + ".UnionMask"
+}
+
+type final struct {
+	*Data
+	Imports []string
+}
+
+// Render renders the methods that gqlgen's executor calls to
+// resolve the _service and _entities fields of a federated schema.
+func Render(data *Data, out io.Writer) error {
+	mp := map[string]struct{}{}
+	if data.ServiceImportPath != "" {
+		mp[data.ServiceImportPath] = struct{}{}
+	}
+	if data.ModelImportPath != "" && len(data.Entities) > 0 {
+		mp[data.ModelImportPath] = struct{}{}
+	}
+	for _, e := range data.Entities {
+		mp[e.RequestImportPath] = struct{}{}
+	}
+	final := &final{Data: data}
+	for k := range mp {
+		final.Imports = append(final.Imports, k)
+	}
+	sort.Strings(final.Imports)
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, final)
+	if err != nil {
+		return err
+	}
+	bts, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, bytes.NewReader(bts))
+	return err
+}
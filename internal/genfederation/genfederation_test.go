@@ -0,0 +1,84 @@
+package genfederation
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite all golden files")
+
+func TestGenFederation(t *testing.T) {
+	d := &Data{
+		PackageName:       "gengraphql",
+		ServiceImportPath: "pkg.go/library",
+		ServicePkg:        "library",
+		ServiceName:       "Library",
+		SDL:               "extend type Query {\n\tgetBook(req: GetBookReq): Book!\n}\n",
+		Entities: []*Entity{{
+			Name:              "Book",
+			RPC:               "GetBook",
+			RequestImportPath: "pkg.go/library",
+			RequestPkg:        "library",
+			RequestType:       "GetBookReq",
+			Fields:            map[string]string{"id": "id"},
+		}, {
+			Name:              "People_Author",
+			RPC:               "FindAuthor",
+			RequestImportPath: "pkg.go/people",
+			RequestPkg:        "people",
+			RequestType:       "FindAuthorReq",
+			Fields:            map[string]string{"first": "first_name", "last": "last_name"},
+		}},
+	}
+
+	var b bytes.Buffer
+	err := Render(d, &b)
+	require.NoError(t, err)
+
+	if *update {
+		ioutil.WriteFile("testdata/federation.golden", b.Bytes(), 0660)
+		return
+	}
+
+	expected, err := ioutil.ReadFile("testdata/federation.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), b.String())
+}
+
+func TestGenFederationExternalResolvers(t *testing.T) {
+	d := &Data{
+		PackageName:       "exec",
+		ServiceImportPath: "pkg.go/library",
+		ServicePkg:        "library",
+		ServiceName:       "Library",
+		SDL:               "extend type Query {\n\tgetBook(req: GetBookReq): Book!\n}\n",
+		Entities: []*Entity{{
+			Name:              "Book",
+			RPC:               "GetBook",
+			RequestImportPath: "pkg.go/library",
+			RequestPkg:        "library",
+			RequestType:       "GetBookReq",
+			Fields:            map[string]string{"id": "id"},
+		}},
+		ModelImportPath:   "pkg.go/graph/model",
+		ModelPkg:          "model",
+		ExternalResolvers: true,
+	}
+
+	var b bytes.Buffer
+	err := Render(d, &b)
+	require.NoError(t, err)
+
+	if *update {
+		ioutil.WriteFile("testdata/external.golden", b.Bytes(), 0660)
+		return
+	}
+
+	expected, err := ioutil.ReadFile("testdata/external.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), b.String())
+}
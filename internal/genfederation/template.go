@@ -0,0 +1,119 @@
+package genfederation
+
+const tmplStr = `// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"context"
+	"fmt"
+	{{- if .Entities }}
+	"bytes"
+	"encoding/json"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/99designs/gqlgen/graphql"
+	{{- end }}
+	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
+	{{ range .Imports }}
+	"{{.}}"{{ end }}
+)
+
+// sdl is the schema of the subgraph.
+const sdl = {{ q .SDL }}
+
+func (ec *executionContext) __resolve__service(ctx context.Context) (fedruntime.Service, error) {
+	return fedruntime.Service{SDL: sdl}, nil
+}
+
+// service returns the service of the executor's resolvers.
+func (ec *executionContext) service() ({{.ServiceType}}, error) {
+	{{- if .ExternalResolvers }}
+	service, ok := ec.resolvers.({{.ServiceType}})
+	if !ok {
+		return nil, fmt.Errorf("unexpected resolvers %T", ec.resolvers)
+	}
+	return service, nil
+	{{- else }}
+	r, ok := ec.resolvers.(*Resolver)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resolvers %T", ec.resolvers)
+	}
+	return r.{{.ServiceName}}, nil
+	{{- end }}
+}
+{{- if .Entities }}
+
+func (ec *executionContext) __resolve_entities(ctx context.Context, representations []map[string]interface{}) ([]{{.UnionMask}}, error) {
+	service, err := ec.service()
+	if err != nil {
+		return nil, err
+	}
+	return resolveEntities(ctx, service, representations)
+}
+
+// resolveEntities looks up each representation of the _entities
+// field, in the order of the representations. A representation that
+// cannot be looked up is null, with an error at its index, so that
+// the other entities of the router's batch are still returned.
+func resolveEntities(ctx context.Context, service {{.ServiceType}}, representations []map[string]interface{}) ([]{{.UnionMask}}, error) {
+	entities := make([]{{.UnionMask}}, len(representations))
+	for i, rep := range representations {
+		entity, err := resolveEntity(ctx, service, rep)
+		if err != nil {
+			index := i
+			graphql.AddError(graphql.WithFieldContext(ctx, &graphql.FieldContext{Index: &index}), err)
+			continue
+		}
+		entities[i] = entity
+	}
+	return entities, nil
+}
+
+// resolveEntity looks up a representation
+// with the lookup RPC of its __typename.
+func resolveEntity(ctx context.Context, service {{.ServiceType}}, rep map[string]interface{}) ({{.UnionMask}}, error) {
+	typeName, _ := rep["__typename"].(string)
+	switch typeName {
+	{{- range .Entities }}
+	case "{{.Name}}":
+		req := &{{.RequestPkg}}.{{.RequestType}}{}
+		err := unmarshalRepresentation(rep, map[string]string{
+			{{- range $field, $key := .Fields }}
+			"{{$field}}": "{{$key}}",
+			{{- end }}
+		}, req)
+		if err != nil {
+			return nil, fmt.Errorf("{{.Name}} representation: %v", err)
+		}
+		entity, err := service.{{.RPC}}(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return entity, nil
+	{{- end }}
+	default:
+		return nil, fmt.Errorf("unknown entity type %q", typeName)
+	}
+}
+
+// unmarshalRepresentation sets each request field of
+// fields to the value of its key field in the representation.
+func unmarshalRepresentation(rep map[string]interface{}, fields map[string]string, req proto.Message) error {
+	values := map[string]interface{}{}
+	for field, key := range fields {
+		v, ok := rep[key]
+		if !ok {
+			return fmt.Errorf("missing key field %v", key)
+		}
+		values[field] = v
+	}
+	bts, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return jsonpb.Unmarshal(bytes.NewReader(bts), req)
+}
+{{- end }}
+`
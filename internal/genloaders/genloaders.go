@@ -0,0 +1,89 @@
+package genloaders
+
+import (
+	"bytes"
+	"go/format"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+var tmpl = template.Must(template.New("genloaders").Funcs(template.FuncMap{
+	"lcFirst": func(s string) string {
+		return strings.ToLower(s[:1]) + s[1:]
+	},
+}).Parse(tmplStr))
+
+// Data is what's needed to render the
+// DataLoaders of a service's batch RPCs.
+type Data struct {
+	PackageName       string
+	ServiceImportPath string
+	ServicePkg        string
+	ServiceName       string
+	Loaders           []*Loader
+}
+
+// Loader describes a batch RPC whose request
+// holds a repeated scalar field of keys and whose
+// response holds a repeated message field with an
+// item for each key, in the same order.
+type Loader struct {
+	// Name is the Go name of the RPC.
+	Name string
+
+	RequestImportPath string
+	RequestPkg        string
+	RequestType       string
+	KeysField         string
+	KeyType           string
+
+	ItemImportPath string
+	ItemPkg        string
+	ItemType       string
+	ItemsField     string
+}
+
+// ServiceType is the Go type of the service, which is declared
+// in the generated package when it has no ServicePkg.
+func (d *Data) ServiceType() string {
+	if d.ServicePkg == "" {
+		return d.ServiceName
+	}
+	return d.ServicePkg + "." + d.ServiceName
+}
+
+type final struct {
+	*Data
+	Imports []string
+}
+
+// Render renders the DataLoaders of the given batch RPCs
+// along with the Loaders type that holds them for a request.
+func Render(data *Data, out io.Writer) error {
+	mp := map[string]struct{}{}
+	if data.ServiceImportPath != "" {
+		mp[data.ServiceImportPath] = struct{}{}
+	}
+	for _, l := range data.Loaders {
+		mp[l.RequestImportPath] = struct{}{}
+		mp[l.ItemImportPath] = struct{}{}
+	}
+	final := &final{Data: data}
+	for k := range mp {
+		final.Imports = append(final.Imports, k)
+	}
+	sort.Strings(final.Imports)
+	var b bytes.Buffer
+	err := tmpl.Execute(&b, final)
+	if err != nil {
+		return err
+	}
+	bts, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, bytes.NewReader(bts))
+	return err
+}
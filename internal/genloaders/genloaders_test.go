@@ -0,0 +1,46 @@
+package genloaders
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite all golden files")
+
+func TestGenLoaders(t *testing.T) {
+	d := &Data{
+		PackageName:       "gengraphql",
+		ServiceImportPath: "pkg.go/library",
+		ServicePkg:        "library",
+		ServiceName:       "Library",
+		Loaders: []*Loader{{
+			Name:              "BatchGetAuthors",
+			RequestImportPath: "pkg.go/library",
+			RequestPkg:        "library",
+			RequestType:       "BatchGetAuthorsReq",
+			KeysField:         "Ids",
+			KeyType:           "string",
+			ItemImportPath:    "pkg.go/people",
+			ItemPkg:           "people",
+			ItemType:          "Author",
+			ItemsField:        "Authors",
+		}},
+	}
+
+	var b bytes.Buffer
+	err := Render(d, &b)
+	require.NoError(t, err)
+
+	if *update {
+		ioutil.WriteFile("testdata/loaders.golden", b.Bytes(), 0660)
+		return
+	}
+
+	expected, err := ioutil.ReadFile("testdata/loaders.golden")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), b.String())
+}
@@ -0,0 +1,154 @@
+package genloaders
+
+const tmplStr = `// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	{{ range .Imports }}
+	"{{.}}"{{ end }}
+)
+
+// Loaders holds the DataLoaders of a single GraphQL request
+// so that fields resolved through batch RPCs are fetched
+// together instead of one call per field.
+type Loaders struct {
+	{{- range .Loaders }}
+	{{.Name}} *{{.Name}}Loader
+	{{- end }}
+}
+
+// NewLoaders returns the DataLoaders of the service's batch RPCs.
+// A batch is sent once it holds maxBatch keys, or wait after
+// its first key was added. A maxBatch of 0 means no limit.
+func NewLoaders(service {{.ServiceType}}, maxBatch int, wait time.Duration) *Loaders {
+	return &Loaders{
+		{{- range .Loaders }}
+		{{.Name}}: New{{.Name}}Loader(func(ctx context.Context, keys []{{.KeyType}}) ([]*{{.ItemPkg}}.{{.ItemType}}, error) {
+			resp, err := service.{{.Name}}(ctx, &{{.RequestPkg}}.{{.RequestType}}{ {{- .KeysField}}: keys})
+			if err != nil {
+				return nil, err
+			}
+			return resp.{{.ItemsField}}, nil
+		}, maxBatch, wait),
+		{{- end }}
+	}
+}
+
+type loadersKey struct{}
+
+// WithLoaders returns a context that carries the given loaders.
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, loaders)
+}
+
+// loadersFrom returns the loaders of the request. Handler adds
+// them to every request, but an executable schema that is served
+// some other way must add them with WithLoaders: without them,
+// each field gets loaders that do not outlive its own call, so
+// that batch RPCs are called once per field instead of batched.
+func loadersFrom(ctx context.Context, service {{.ServiceType}}) *Loaders {
+	if loaders, ok := ctx.Value(loadersKey{}).(*Loaders); ok {
+		return loaders
+	}
+	return NewLoaders(service, 0, 0)
+}
+{{ range .Loaders }}
+// {{.Name}}Loader batches and caches the calls to {{.Name}}.
+type {{.Name}}Loader struct {
+	fetch    func(ctx context.Context, keys []{{.KeyType}}) ([]*{{.ItemPkg}}.{{.ItemType}}, error)
+	maxBatch int
+	wait     time.Duration
+
+	mu    sync.Mutex
+	cache map[{{.KeyType}}]*{{lcFirst .Name}}Result
+	batch *{{lcFirst .Name}}Batch
+}
+
+// {{lcFirst .Name}}Result is the eventual result of a key.
+type {{lcFirst .Name}}Result struct {
+	done chan struct{}
+	item *{{.ItemPkg}}.{{.ItemType}}
+	err  error
+}
+
+type {{lcFirst .Name}}Batch struct {
+	keys    []{{.KeyType}}
+	results []*{{lcFirst .Name}}Result
+	sent    bool
+}
+
+// New{{.Name}}Loader returns a loader that passes batches of
+// keys to fetch, which must return an item for each key in
+// the same order.
+func New{{.Name}}Loader(fetch func(ctx context.Context, keys []{{.KeyType}}) ([]*{{.ItemPkg}}.{{.ItemType}}, error), maxBatch int, wait time.Duration) *{{.Name}}Loader {
+	return &{{.Name}}Loader{
+		fetch:    fetch,
+		maxBatch: maxBatch,
+		wait:     wait,
+		cache:    map[{{.KeyType}}]*{{lcFirst .Name}}Result{},
+	}
+}
+
+// Load returns the item of the given key once the batch that
+// holds it has been fetched. The batch is fetched with the
+// values of the context of one of its calls, but not with its
+// cancelation, since the other calls of the batch wait for it.
+func (l *{{.Name}}Loader) Load(ctx context.Context, key {{.KeyType}}) (*{{.ItemPkg}}.{{.ItemType}}, error) {
+	l.mu.Lock()
+	res, ok := l.cache[key]
+	if !ok {
+		res = &{{lcFirst .Name}}Result{done: make(chan struct{})}
+		l.cache[key] = res
+		if l.batch == nil {
+			b := &{{lcFirst .Name}}Batch{}
+			l.batch = b
+			time.AfterFunc(l.wait, func() { l.send(context.WithoutCancel(ctx), b) })
+		}
+		b := l.batch
+		b.keys = append(b.keys, key)
+		b.results = append(b.results, res)
+		if l.maxBatch > 0 && len(b.keys) >= l.maxBatch {
+			l.batch = nil
+			go l.send(context.WithoutCancel(ctx), b)
+		}
+	}
+	l.mu.Unlock()
+	select {
+	case <-res.done:
+		return res.item, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (l *{{.Name}}Loader) send(ctx context.Context, b *{{lcFirst .Name}}Batch) {
+	l.mu.Lock()
+	if b.sent {
+		l.mu.Unlock()
+		return
+	}
+	b.sent = true
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+	items, err := l.fetch(ctx, b.keys)
+	if err == nil && len(items) != len(b.keys) {
+		err = fmt.Errorf("{{.Name}} returned %d items for %d keys", len(items), len(b.keys))
+	}
+	for i, res := range b.results {
+		if err != nil {
+			res.err = err
+		} else {
+			res.item = items[i]
+		}
+		close(res.done)
+	}
+}
+{{ end }}`
@@ -0,0 +1,94 @@
+package genresolver
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// FieldResolver describes a field of a type that
+// is resolved by calling another RPC of the service.
+type FieldResolver struct {
+	RPC               string
+	RequestImportPath string
+	RequestType       string
+	Args              []*FieldResolverArg
+
+	// Loader resolves the field through the DataLoader of
+	// a batch RPC, with its single argument as the key.
+	Loader bool
+}
+
+// FieldResolverArg copies a field of the parent
+// object into a field of the RPC's request.
+// Both are Go field names.
+type FieldResolverArg struct {
+	RequestField string
+	ObjectField  string
+}
+
+// fieldResolver returns the body of a resolver
+// that builds the request of a FieldResolver
+// from its parent object and calls the RPC.
+func (m *Plugin) fieldResolver(data *codegen.Data, o *codegen.Object, f *codegen.Field) (string, error) {
+	fr := m.FieldResolvers[o.Name+"."+f.Name]
+	reqFields, err := requestFields(data, fr.RequestImportPath, fr.RequestType)
+	if err != nil {
+		return "", err
+	}
+	objFields, err := structFields(o.Type)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if fr.Loader {
+		arg := fr.Args[0]
+		keys, ok := reqFields[arg.RequestField].(*types.Slice)
+		if !ok || objFields[arg.ObjectField] == nil {
+			return "", fmt.Errorf("%v.%v: unknown field %v or %v", o.Name, f.Name, arg.RequestField, arg.ObjectField)
+		}
+		if key, ok := convert(keys.Elem(), objFields[arg.ObjectField], "obj."+arg.ObjectField); ok {
+			fmt.Fprintf(&b, "return loadersFrom(ctx, r.%v).%v.Load(ctx, %v)", m.ServiceName, fr.RPC, key)
+			return b.String(), nil
+		}
+		code, err := assign(keys.Elem(), objFields[arg.ObjectField], "key", "obj."+arg.ObjectField)
+		if err != nil {
+			return "", fmt.Errorf("%v.%v: %v", o.Name, f.Name, err)
+		}
+		fmt.Fprintf(&b, "var key %v\n%v", templates.CurrentImports.LookupType(keys.Elem()), code)
+		fmt.Fprintf(&b, "return loadersFrom(ctx, r.%v).%v.Load(ctx, key)", m.ServiceName, fr.RPC)
+		return b.String(), nil
+	}
+	fmt.Fprintf(&b, "req := &%v.%v{}\n", templates.CurrentImports.Lookup(fr.RequestImportPath), fr.RequestType)
+	for _, arg := range fr.Args {
+		dst, src := reqFields[arg.RequestField], objFields[arg.ObjectField]
+		if dst == nil || src == nil {
+			return "", fmt.Errorf("%v.%v: unknown field %v or %v", o.Name, f.Name, arg.RequestField, arg.ObjectField)
+		}
+		code, err := assign(dst, src, "req."+arg.RequestField, "obj."+arg.ObjectField)
+		if err != nil {
+			return "", fmt.Errorf("%v.%v: %v", o.Name, f.Name, err)
+		}
+		b.WriteString(code)
+	}
+	fmt.Fprintf(&b, "return r.%v.%v(ctx, req)", m.ServiceName, fr.RPC)
+	return b.String(), nil
+}
+
+func structFields(t types.Type) (map[string]types.Type, error) {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a struct", t)
+	}
+	fields := map[string]types.Type{}
+	for i := 0; i < st.NumFields(); i++ {
+		fields[st.Field(i).Name()] = st.Field(i).Type()
+	}
+	return fields, nil
+}
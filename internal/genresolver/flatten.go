@@ -0,0 +1,154 @@
+package genresolver
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// Flatten describes an RPC whose request fields are
+// exposed as individual GraphQL arguments instead of
+// a single req input.
+type Flatten struct {
+	RequestImportPath string
+	RequestType       string
+	// Fields maps the GraphQL argument names
+	// to the Go names of the request fields.
+	Fields map[string]string
+}
+
+// resolverLocals are the variables that the generated resolvers
+// declare next to their arguments. The request of a flattened RPC
+// is _req instead, since Go names of arguments have no underscores.
+var resolverLocals = map[string]bool{
+	"r": true, "ctx": true, "resp": true, "err": true,
+	"pageToken": true, "offset": true, "nodes": true,
+	"cursors": true, "start": true, "end": true, "conn": true,
+}
+
+// flattenRequest returns the code that rebuilds the protobuf
+// request of a flattened RPC from the resolver's arguments.
+func (m *Plugin) flattenRequest(data *codegen.Data, f *codegen.Field) (string, error) {
+	flatten := m.Flattens[f.GoFieldName]
+	fieldTypes, err := requestFields(data, flatten.RequestImportPath, flatten.RequestType)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "_req := &%v.%v{}\n", templates.CurrentImports.Lookup(flatten.RequestImportPath), flatten.RequestType)
+	for _, arg := range f.Args {
+		if resolverLocals[arg.VarName] {
+			return "", fmt.Errorf("argument %v of %v is named like a variable of its resolver", arg.Name, f.Name)
+		}
+		name, ok := flatten.Fields[arg.Name]
+		if !ok {
+			continue
+		}
+		dst, ok := fieldTypes[name]
+		if !ok {
+			return "", fmt.Errorf("%v has no field %v", flatten.RequestType, name)
+		}
+		code, err := assign(dst, arg.TypeReference.GO, "_req."+name, arg.VarName)
+		if err != nil {
+			return "", fmt.Errorf("argument %v of %v: %v", arg.Name, f.Name, err)
+		}
+		b.WriteString(code)
+	}
+	return b.String(), nil
+}
+
+// requestFields returns the types of the
+// fields of a protobuf request by Go name.
+func requestFields(data *codegen.Data, importPath, typeName string) (map[string]types.Type, error) {
+	pkg := data.Config.Packages.LoadWithTypes(importPath)
+	if pkg == nil || pkg.Types == nil {
+		return nil, fmt.Errorf("could not load %v", importPath)
+	}
+	obj := pkg.Types.Scope().Lookup(typeName)
+	if obj == nil {
+		return nil, fmt.Errorf("%v.%v is not defined", importPath, typeName)
+	}
+	return structFields(obj.Type())
+}
+
+// assign returns the statements that set lhs, of type dst,
+// to the value of rhs, of type src. GraphQL arguments are
+// pointers when they are nullable and use Go's int and
+// float64 for all numbers, so they may need to be
+// dereferenced and converted to match the protobuf field.
+func assign(dst, src types.Type, lhs, rhs string) (string, error) {
+	if expr, ok := convert(dst, src, rhs); ok {
+		return fmt.Sprintf("%v = %v\n", lhs, expr), nil
+	}
+	if srcPtr, ok := src.(*types.Pointer); ok {
+		if dstPtr, ok := dst.(*types.Pointer); ok {
+			if expr, ok := convert(dstPtr.Elem(), srcPtr.Elem(), "*"+rhs); ok {
+				return fmt.Sprintf("if %v != nil {\nv := %v\n%v = &v\n}\n", rhs, expr, lhs), nil
+			}
+		}
+		code, err := assign(dst, srcPtr.Elem(), lhs, "*"+rhs)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("if %v != nil {\n%v}\n", rhs, code), nil
+	}
+	if dstPtr, ok := dst.(*types.Pointer); ok {
+		if expr, ok := convert(dstPtr.Elem(), src, rhs); ok {
+			if expr == rhs {
+				return fmt.Sprintf("%v = &%v\n", lhs, rhs), nil
+			}
+			return fmt.Sprintf("{\nv := %v\n%v = &v\n}\n", expr, lhs), nil
+		}
+	}
+	srcSlice, srcOk := src.(*types.Slice)
+	dstSlice, dstOk := dst.(*types.Slice)
+	if srcOk && dstOk {
+		code, err := assign(dstSlice.Elem(), srcSlice.Elem(), "e", "v")
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(
+			"for _, v := range %v {\nvar e %v\n%v%v = append(%v, e)\n}\n",
+			rhs, templates.CurrentImports.LookupType(dstSlice.Elem()), code, lhs, lhs,
+		), nil
+	}
+	return "", fmt.Errorf("cannot use %v as %v", src, dst)
+}
+
+// convert returns an expression of type dst for
+// rhs if it only takes a Go type conversion.
+func convert(dst, src types.Type, rhs string) (string, bool) {
+	if types.Identical(dst, src) || dst.String() == src.String() {
+		return rhs, true
+	}
+	dstBasic, dstOk := dst.Underlying().(*types.Basic)
+	srcBasic, srcOk := src.Underlying().(*types.Basic)
+	numeric := types.IsInteger | types.IsFloat
+	switch {
+	case dstOk && srcOk:
+		if dstBasic.Info()&numeric == 0 && dstBasic.Kind() != srcBasic.Kind() {
+			return "", false
+		}
+		if dstBasic.Info()&numeric != 0 && srcBasic.Info()&numeric == 0 {
+			return "", false
+		}
+	case dstOk != srcOk:
+		return "", false
+	case isPointer(dst):
+		return "", false
+	default:
+		if !types.ConvertibleTo(src, dst) && src.Underlying().String() != dst.Underlying().String() {
+			return "", false
+		}
+	}
+	return fmt.Sprintf("%v(%v)", templates.CurrentImports.LookupType(dst), rhs), true
+}
+
+func isPointer(t types.Type) bool {
+	_, ok := t.(*types.Pointer)
+	return ok
+}
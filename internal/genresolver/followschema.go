@@ -0,0 +1,109 @@
+package genresolver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/99designs/gqlgen/codegen"
+	pgs "github.com/lyft/protoc-gen-star"
+)
+
+// generatedRE matches the comment that marks generated Go files.
+var generatedRE = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+// embedRE matches the generated resolver that the
+// resolver of an object embeds in a file users own.
+var embedRE = regexp.MustCompile(`(?m)^\s*generated(\w+)Resolver\s*$`)
+
+// ObjectBuild is the data of the resolver of an object
+// in a file of the follow-schema layout that users own.
+type ObjectBuild struct {
+	*ResolverBuild
+	Object *codegen.Object
+}
+
+// generateFollowSchema renders the resolvers that call the service
+// into resolver.gen.go, next to the files that users own: the
+// Resolver type in resolver.go and the resolver of each object,
+// which embeds the generated one, in <object>.resolvers.go. Those
+// are only created when they are missing, or still hold the code
+// of the single-file layout, and are never overwritten after.
+func (m *Plugin) generateFollowSchema(data *codegen.Data, build *ResolverBuild) error {
+	dir := data.Config.Resolver.Dir()
+	orphans, err := orphanedObjects(dir, data.Objects)
+	if err != nil {
+		return err
+	}
+	build.Orphans = orphans
+	err = m.render(data, tmpl, filepath.Join(dir, "resolver.gen.go"), true, build)
+	if err != nil {
+		return err
+	}
+	err = m.create(data, userResolverTmpl, data.Config.Resolver.Filename, build)
+	if err != nil {
+		return err
+	}
+	for _, o := range data.Objects {
+		if !o.HasResolvers() {
+			continue
+		}
+		filename := filepath.Join(dir, pgs.Name(o.Name).LowerSnakeCase().String()+".resolvers.go")
+		err = m.create(data, userObjectTmpl, filename, &ObjectBuild{ResolverBuild: build, Object: o})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// orphanedObjects returns the objects whose generated resolver is
+// embedded in a file of dir that users own, but that no longer have
+// resolvers, such as after their last field resolver was removed.
+// Their generated resolvers are still declared, empty, so that the
+// files compile until users delete them.
+func orphanedObjects(dir string, objects codegen.Objects) ([]string, error) {
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.resolvers.go"))
+	if err != nil {
+		return nil, err
+	}
+	declared := map[string]bool{}
+	for _, o := range objects {
+		if o.HasResolvers() {
+			declared[o.Name] = true
+		}
+	}
+	orphans := []string{}
+	for _, filename := range filenames {
+		bts, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		if generatedRE.Match(bts) {
+			continue
+		}
+		for _, match := range embedRE.FindAllSubmatch(bts, -1) {
+			name := string(match[1])
+			if !declared[name] {
+				declared[name] = true
+				orphans = append(orphans, name)
+			}
+		}
+	}
+	sort.Strings(orphans)
+	return orphans, nil
+}
+
+// create renders a template into filename, unless
+// it exists and is not generated code.
+func (m *Plugin) create(data *codegen.Data, tmpl, filename string, build interface{}) error {
+	bts, err := ioutil.ReadFile(filename)
+	if err == nil && !generatedRE.Match(bts) {
+		return nil
+	} else if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return m.render(data, tmpl, filename, false, build)
+}
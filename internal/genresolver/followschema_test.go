@@ -0,0 +1,41 @@
+package genresolver
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestOrphanedObjects(t *testing.T) {
+	dir := t.TempDir()
+	book := &codegen.Object{
+		Definition: &ast.Definition{Name: "Book"},
+		Fields:     []*codegen.Field{{IsResolver: true}},
+	}
+	query := &codegen.Object{
+		Definition: &ast.Definition{Name: "Query"},
+		Fields:     []*codegen.Field{{IsResolver: true}},
+	}
+	for name, content := range map[string]string{
+		"book.resolvers.go":  "package gengraphql\n\ntype bookResolver struct {\n\tgeneratedBookResolver\n}\n",
+		"query.resolvers.go": "package gengraphql\n\ntype queryResolver struct {\n\tgeneratedQueryResolver\n}\n",
+		// Generated files, such as those of gqlgen's own layout, are not owned by users.
+		"author.resolvers.go": "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage gengraphql\n\ntype authorResolver struct {\n\tgeneratedAuthorResolver\n}\n",
+	} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0666))
+	}
+
+	orphans, err := orphanedObjects(dir, codegen.Objects{book, query})
+	require.NoError(t, err)
+	require.Empty(t, orphans)
+
+	// Regenerating after the field resolver of Book was removed.
+	book.Fields[0].IsResolver = false
+	orphans, err = orphanedObjects(dir, codegen.Objects{book, query})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Book"}, orphans)
+}
@@ -1,43 +1,87 @@
 package genresolver
 
 import (
-	"fmt"
+	"path/filepath"
 	"strings"
 	"text/template"
 
 	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/codegen/templates"
 	"github.com/99designs/gqlgen/plugin"
 )
 
 func New(
 	serviceName,
+	schemaFilename,
+	serviceImportPath,
 	pkgName string,
 	emptys []string,
 	scalars map[string]string,
 	unions map[string]bool,
-	responseUnions map[string]string,
-	sdl string,
+	responseUnions map[string][]*ErrorType,
+	connections map[string]*Connection,
+	flattens map[string]*Flatten,
+	fieldResolvers map[string]*FieldResolver,
 ) plugin.Plugin {
 	return &Plugin{
-		ServiceName:    serviceName,
-		PackageName:    pkgName,
-		Emptys:         emptys,
-		Scalars:        scalars,
-		Unions:         unions,
-		ResponseUnions: responseUnions,
-		SDL:            sdl,
+		ServiceName:       serviceName,
+		SchemaFilename:    schemaFilename,
+		ServiceImportPath: serviceImportPath,
+		PackageName:       pkgName,
+		Emptys:            emptys,
+		Scalars:           scalars,
+		Unions:            unions,
+		ResponseUnions:    responseUnions,
+		Connections:       connections,
+		Flattens:          flattens,
+		FieldResolvers:    fieldResolvers,
 	}
 }
 
 type Plugin struct {
-	ServiceName    string
-	PackageName    string
-	Emptys         []string
-	Scalars        map[string]string
-	Unions         map[string]bool
-	ResponseUnions map[string]string
-	SDL            string
+	ServiceName string
+	// SchemaFilename is the generated schema, whose
+	// fields are resolved by calling the service. The
+	// fields of other schema files are left to users.
+	SchemaFilename    string
+	ServiceImportPath string
+	PackageName       string
+	Emptys            []string
+	Scalars           map[string]string
+	Unions            map[string]bool
+	ResponseUnions    map[string][]*ErrorType
+	Connections       map[string]*Connection
+	Flattens          map[string]*Flatten
+	FieldResolvers    map[string]*FieldResolver
+}
+
+// ErrorType is a message that an RPC responds
+// with, as part of its result union, instead
+// of failing with the error that carries it.
+type ErrorType struct {
+	ImportPath string
+	Type       string
+}
+
+// Connection describes how an AIP-158 List RPC
+// is exposed as a Relay connection. All names
+// are the Go names of the generated protobuf
+// types and fields.
+type Connection struct {
+	RequestImportPath string
+	RequestType       string
+	PageSize          string
+	PageSizeType      string
+	PageToken         string
+	NextPageToken     string
+	Items             string
+	ConnectionType    string
+	EdgeType          string
+	// ModelImportPath is the import path of the package of the
+	// connection and edge types, empty when it is the package
+	// of the resolvers.
+	ModelImportPath string
 }
 
 func (m *Plugin) isEmpty(f *codegen.Field) bool {
@@ -66,17 +110,24 @@ func (m *Plugin) GenerateCode(data *codegen.Data) error {
 		PackageName:        data.Config.Resolver.Package,
 		ResolverType:       data.Config.Resolver.Type,
 		ServiceName:        m.ServiceName,
+		ServiceImportPath:  m.ServiceImportPath,
 		ServicePackageName: m.PackageName,
-		SDL:                m.SDL,
-		Federated:          m.SDL != "",
+		FollowSchema:       data.Config.Resolver.Layout == config.LayoutFollowSchema,
 	}
+	if resolverBuild.FollowSchema {
+		return m.generateFollowSchema(data, resolverBuild)
+	}
+	return m.render(data, tmpl, data.Config.Resolver.Filename, true, resolverBuild)
+}
 
+// render renders a template of the resolvers into filename.
+func (m *Plugin) render(data *codegen.Data, tmpl, filename string, generated bool, build interface{}) error {
 	return templates.Render(templates.Options{
-		GeneratedHeader: true,
+		GeneratedHeader: generated,
 		Template:        tmpl,
 		PackageName:     data.Config.Resolver.Package,
-		Filename:        data.Config.Resolver.Filename,
-		Data:            resolverBuild,
+		Filename:        filename,
+		Data:            build,
 		Packages:        data.Config.Packages,
 		Funcs: template.FuncMap{
 			"hasPrefix": hasPrefix,
@@ -96,11 +147,42 @@ func (m *Plugin) GenerateCode(data *codegen.Data) error {
 				_, ok := m.ResponseUnions[s]
 				return ok
 			},
-			"responseUnionName": func(s string) string {
-				return m.ResponseUnions[s]
+			"responseErrors": func(s string) string {
+				types := []string{}
+				for _, e := range m.ResponseUnions[s] {
+					types = append(types, "*"+templates.CurrentImports.Lookup(e.ImportPath)+"."+e.Type)
+				}
+				return strings.Join(types, ", ")
+			},
+			"isConnection": func(s string) bool {
+				_, ok := m.Connections[s]
+				return ok
+			},
+			"connection": func(s string) *Connection {
+				return m.Connections[s]
+			},
+			"qualify": func(importPath, name string) string {
+				if pkg := templates.CurrentImports.Lookup(importPath); pkg != "" {
+					return pkg + "." + name
+				}
+				return name
+			},
+			"isFlattened": func(s string) bool {
+				_, ok := m.Flattens[s]
+				return ok
+			},
+			"flattenRequest": func(f *codegen.Field) (string, error) {
+				return m.flattenRequest(data, f)
+			},
+			"isGenerated": func(f *codegen.Field) bool {
+				return f.Position != nil && f.Position.Src != nil && f.Position.Src.Name == filepath.ToSlash(m.SchemaFilename)
+			},
+			"isFieldResolver": func(o *codegen.Object, f *codegen.Field) bool {
+				_, ok := m.FieldResolvers[o.Name+"."+f.Name]
+				return ok
 			},
-			"q": func(s string) string {
-				return fmt.Sprintf("%q", s)
+			"fieldResolver": func(o *codegen.Object, f *codegen.Field) (string, error) {
+				return m.fieldResolver(data, o, f)
 			},
 		},
 	})
@@ -112,9 +194,16 @@ type ResolverBuild struct {
 	PackageName        string
 	ResolverType       string
 	ServiceName        string
+	ServiceImportPath  string
 	ServicePackageName string
-	SDL                string
-	Federated          bool
+	// FollowSchema is set for the follow-schema layout, where
+	// the resolvers that call the service are generated apart
+	// from the ones that users write.
+	FollowSchema bool
+	// Orphans are the objects of the follow-schema layout
+	// that lost their resolvers, while a file that users
+	// own still embeds their generated resolver.
+	Orphans []string
 }
 
 func hasPrefix(s, prefix string) bool {
@@ -17,54 +17,106 @@ var tmpl = `
 {{ reserveImport "github.com/99designs/gqlgen/graphql/introspection" }}
 {{ $serviceName := .ServiceName }}
 {{ $servicePackageName := .ServicePackageName }}
-{{ $federated := .Federated }}
-{{ $sdl := .SDL }}
+{{- if not .FollowSchema }}
 type {{.ResolverType}} struct {
-    {{$servicePackageName}}.{{$serviceName}}
+    {{ if $servicePackageName }}{{$servicePackageName}}.{{ end }}{{$serviceName}}
 }
+{{- end }}
 
 {{ range $object := .Objects -}}
 	{{- if $object.HasResolvers -}}
 		func (r *{{$.ResolverType}}) {{$object.Name}}() {{ $object.ResolverInterface | ref }} {
+			{{- if $.FollowSchema }}
+			return &{{lcFirst $object.Name}}Resolver{generated{{$object.Name}}Resolver{r}}
+			{{- else }}
 			return &{{lcFirst $object.Name}}Resolver{r}
+			{{- end }}
 		}
 	{{ end -}}
 {{ end }}
 
 {{ range $object := .Objects -}}
 	{{- if $object.HasResolvers -}}
-		type {{lcFirst $object.Name}}Resolver struct { *Resolver }
+		{{- $type := print (lcFirst $object.Name) "Resolver" -}}
+		{{- if $.FollowSchema }}
+		{{- $type = print "generated" $object.Name "Resolver" }}
+		// {{$type}} resolves the fields of {{$object.Name}} with the
+		// service. The methods of {{lcFirst $object.Name}}Resolver override its own.
+		{{- end }}
+		type {{$type}} struct { *Resolver }
 
 		{{ range $field := $object.Fields -}}
-			{{- if $field.IsResolver -}}
-			func (r *{{lcFirst $object.Name}}Resolver) {{$field.GoFieldName}}{{ $field.ShortResolverDeclaration }} {
+			{{- if and $field.IsResolver (isGenerated $field) -}}
+			func (r *{{$type}}) {{$field.GoFieldName}}{{ $field.ShortResolverDeclaration }} {
 				{{- $reqArg := "req" -}}
-				{{- if (hasPrefix ($field.ShortResolverDeclaration) "(ctx context.Context)") -}}
+				{{- $flattened := (and $object.Root (isFlattened ($field.GoFieldName))) -}}
+				{{- if $flattened -}}
+					{{ $reqArg = "_req" }}
+					{{- flattenRequest $field }}
+				{{- else if (hasPrefix ($field.ShortResolverDeclaration) "(ctx context.Context)") -}}
 					{{ $reqArg = "nil" }}
 				{{ end -}}
-				{{- if (and $federated (eq ($field.GoFieldName) "_service")) -}}
-				return &_Service{Sdl: {{q $sdl}}}, nil
+				{{- if (and $object.Root (isConnection ($field.GoFieldName))) }}
+				{{- $conn := (connection ($field.GoFieldName)) -}}
+				{{- if $flattened -}}
+				{{- else if (hasPrefix ($field.ShortResolverDeclaration) "(ctx context.Context, req ") -}}
+				if req == nil {
+					req = &{{lookupImport $conn.RequestImportPath}}.{{$conn.RequestType}}{}
+				}
+				{{- else -}}
+				req := &{{lookupImport $conn.RequestImportPath}}.{{$conn.RequestType}}{}
+				{{- end }}
+				pageToken, offset, err := decodeCursor(after)
+				if err != nil {
+					return nil, err
+				}
+				{{$reqArg}}.{{$conn.PageToken}} = pageToken
+				if first != nil {
+					if *first < 0 {
+						return nil, errors.New("first must not be negative")
+					}
+					size := *first + offset
+					if size < offset || int({{$conn.PageSizeType}}(size)) != size {
+						return nil, errors.New("first and after exceed the maximum page size")
+					}
+					{{$reqArg}}.{{$conn.PageSize}} = {{$conn.PageSizeType}}(size)
+				}
+				resp, err := r.{{$serviceName}}.{{$field.GoFieldName}}(ctx, {{$reqArg}})
+				if err != nil {
+					return nil, err
+				}
+				nodes := resp.{{$conn.Items}}
+				cursors := pageCursors(pageToken, resp.{{$conn.NextPageToken}}, len(nodes))
+				start, end := pageBounds(offset, first, len(nodes))
+				conn := &{{qualify $conn.ModelImportPath $conn.ConnectionType}}{
+					PageInfo: newPageInfo(
+						cursors[start:end],
+						resp.{{$conn.NextPageToken}} != "" || end < len(nodes),
+						pageToken != "" || start > 0,
+					),
+				}
+				for i := start; i < end; i++ {
+					conn.Edges = append(conn.Edges, &{{qualify $conn.ModelImportPath $conn.EdgeType}}{Cursor: cursors[i], Node: nodes[i]})
+				}
+				return conn, nil
 				{{ else if (isEmpty $field) }}
 				_, err := r.{{$serviceName}}.{{$field.GoFieldName}}(ctx, {{$reqArg}})
 				if err != nil {
 					return nil, err
 				}
 				return {{getType $field}}{}, nil
+				{{ else if (isFieldResolver $object $field) }}
+					{{ fieldResolver $object $field }}
 				{{ else if (isScalar ($field.GoFieldName)) }}
 					return obj.Get{{$field.GoFieldName}}(), nil
 				{{ else if (isUnion ($field.GoFieldName)) }}
 					return obj.Get{{$field.GoFieldName}}(), nil
 				{{ else if (isResponseUnion ($field.GoFieldName)) }}
 				resp, err := r.{{$serviceName}}.{{$field.GoFieldName}}(ctx, {{$reqArg}})
-				if err != nil {
-					{{ $errorTypeName := (responseUnionName ($field.GoFieldName)) }}
-					if errval, ok := err.(interface {
-						{{$errorTypeName}}() *{{$servicePackageName}}.{{$errorTypeName}}
-					}); ok {
-						newresp := errval.{{$errorTypeName}}()
-						if newresp != nil {
-							return newresp, nil
-						}
+				for _, msg := range dataErrors(err) {
+					switch msg := msg.(type) {
+					case {{ responseErrors $field.GoFieldName }}:
+						return msg, nil
 					}
 				}
 				return resp, err
@@ -76,4 +128,31 @@ type {{.ResolverType}} struct {
 		{{ end -}}
 	{{ end -}}
 {{ end }}
+
+{{ range $name := .Orphans -}}
+	// generated{{$name}}Resolver is left for {{lcFirst $name}}Resolver, although
+	// {{$name}} has no resolvers anymore. Delete the file that declares it.
+	type generated{{$name}}Resolver struct { *Resolver }
+{{ end }}
+`
+
+// userResolverTmpl is the Resolver type of the follow-schema
+// layout, in a file that users own once it is created.
+var userResolverTmpl = `
+// {{.ResolverType}} is the root resolver. Add the dependencies of
+// the resolvers that you write to it, after the service.
+type {{.ResolverType}} struct {
+	{{ with lookupImport .ServiceImportPath }}{{.}}.{{ end }}{{.ServiceName}}
+}
+`
+
+// userObjectTmpl is the resolver of an object in the follow-schema
+// layout, in a file that users own once it is created.
+var userObjectTmpl = `
+// {{lcFirst .Object.Name}}Resolver resolves the fields of {{.Object.Name}}.
+// The methods that you declare on it override those of
+// generated{{.Object.Name}}Resolver, which call the service.
+type {{lcFirst .Object.Name}}Resolver struct {
+	generated{{.Object.Name}}Resolver
+}
 `
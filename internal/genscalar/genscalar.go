@@ -10,14 +10,15 @@ import (
 var tmpl = template.Must(template.New("").Parse(templateText))
 
 type data struct {
-	Imports []string
-	Types   map[string]string
+	PackageName string
+	Imports     []string
+	Types       map[string]string
 }
 
 // Render renders a scalar
-// implementation.
-func Render(mp map[string]string, imports map[string]struct{}, out io.Writer) error {
-	d := &data{Types: mp}
+// implementation in package pkgName.
+func Render(pkgName string, mp map[string]string, imports map[string]struct{}, out io.Writer) error {
+	d := &data{PackageName: pkgName, Types: mp}
 	for i := range imports {
 		d.Imports = append(d.Imports, i)
 	}
@@ -36,7 +37,7 @@ func Render(mp map[string]string, imports map[string]struct{}, out io.Writer) er
 
 const templateText = `// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
 
-package gengraphql
+package {{.PackageName}}
 
 import (
 	"encoding/json"
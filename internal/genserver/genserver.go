@@ -1,19 +1,28 @@
 package genserver
 
 import (
+	"text/template"
+
 	"github.com/99designs/gqlgen/codegen"
 	"github.com/99designs/gqlgen/codegen/templates"
 	"github.com/99designs/gqlgen/plugin"
 )
 
-func New(filename, modPath, serviceName string) plugin.Plugin {
-	return &Plugin{filename, modPath, serviceName}
+func New(filename, pkgName, modPath, serviceName, backend string, loaders bool) plugin.Plugin {
+	return &Plugin{filename, pkgName, modPath, serviceName, backend, loaders}
 }
 
 type Plugin struct {
 	filename    string
+	pkgName     string
 	modPath     string
 	serviceName string
+	// backend is the transport of the service, "twirp",
+	// "grpc" or "connect", that Handler calls.
+	backend string
+	// loaders reports whether the service has batch
+	// RPCs whose DataLoaders are added to each request.
+	loaders bool
 }
 
 var _ plugin.CodeGenerator = &Plugin{}
@@ -29,15 +38,27 @@ func (m *Plugin) GenerateCode(data *codegen.Data) error {
 		ResolverPackageName: data.Config.Resolver.ImportPath(),
 		ModPath:             m.modPath,
 		ServiceName:         m.serviceName,
+		Backend:             m.backend,
+		Loaders:             m.loaders,
 	}
 
 	return templates.Render(templates.Options{
 		GeneratedHeader: true,
 		Template:        tmpl,
-		PackageName:     "gengraphql", // TODO: dynamic package name
+		PackageName:     m.pkgName,
 		Filename:        m.filename,
 		Data:            serverBuild,
 		Packages:        data.Config.Packages,
+		Funcs: template.FuncMap{
+			// exec qualifies a name declared in the
+			// package of the executable schema.
+			"exec": func(name string) string {
+				if pkg := templates.CurrentImports.Lookup(serverBuild.ExecPackageName); pkg != "" {
+					return pkg + "." + name
+				}
+				return name
+			},
+		},
 	})
 }
 
@@ -48,4 +69,18 @@ type ServerBuild struct {
 	ResolverPackageName string
 	ModPath             string
 	ServiceName         string
+	Backend             string
+	Loaders             bool
+}
+
+// ServiceField is the name of the field of the
+// Resolver that embeds the service that it calls.
+func (b *ServerBuild) ServiceField() string {
+	switch b.Backend {
+	case "grpc":
+		return b.ServiceName + "Client"
+	case "connect":
+		return "connectService"
+	}
+	return b.ServiceName
 }
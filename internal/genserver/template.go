@@ -1,7 +1,10 @@
 package genserver
 
 var tmpl = `{{ reserveImport "context" }}
+{{ reserveImport "errors" }}
 {{ reserveImport "net/http" }}
+{{ reserveImport "strings" }}
+{{ reserveImport "time" }}
 
 {{ reserveImport "github.com/99designs/gqlgen/graphql/handler" }}
 {{ reserveImport "github.com/twitchtv/twirp" }}
@@ -10,6 +13,13 @@ var tmpl = `{{ reserveImport "context" }}
 {{ reserveImport "github.com/99designs/gqlgen/graphql/handler/extension" }}
 {{ reserveImport "github.com/99designs/gqlgen/graphql/handler/transport" }}
 {{ reserveImport "github.com/99designs/gqlgen/graphql/playground" }}
+{{ reserveImport "google.golang.org/grpc" }}
+{{ reserveImport "google.golang.org/grpc/codes" }}
+{{ reserveImport "google.golang.org/grpc/metadata" }}
+{{ reserveImport "google.golang.org/grpc/status" }}
+{{ reserveImport "google.golang.org/genproto/googleapis/rpc/errdetails" }}
+{{ reserveImport "connectrpc.com/connect" }}
+{{ reserveImport "github.com/vektah/gqlparser/v2/gqlerror" }}
 
 // Playground is a proxy to github.com/99designs/gqlgen/handler.Playground
 // All you need to do is provide a title and the URL Path to the GraphQL handler
@@ -17,14 +27,147 @@ func Playground(title, endpoint string) http.Handler {
 	return playground.Handler(title, endpoint)
 }
 
+// Option configures the handler returned by Handler.
+type Option func(*options)
+
+type options struct {
+	maxBatch    int
+	wait        time.Duration
+	production  bool
+	errorMapper ErrorMapper
+}
+
+// WithLoaderBatch configures the DataLoaders of batch RPCs: a batch
+// is sent once it holds maxBatch keys, or wait after its first key.
+// It defaults to 100 keys and 1ms.
+func WithLoaderBatch(maxBatch int, wait time.Duration) Option {
+	return func(o *options) {
+		o.maxBatch = maxBatch
+		o.wait = wait
+	}
+}
+
+// WithProduction hides the message and meta of internal errors,
+// such as unknown, internal and data_loss errors, from clients.
+func WithProduction() Option {
+	return func(o *options) {
+		o.production = true
+	}
+}
+
+// ErrorMapper maps an error returned by a resolver to the error
+// of the GraphQL response. It returns nil to keep the default.
+type ErrorMapper func(ctx context.Context, err error) *gqlerror.Error
+
+// WithErrorMapper maps the errors of the resolvers with m
+// before they are mapped by the default error presenter.
+func WithErrorMapper(m ErrorMapper) Option {
+	return func(o *options) {
+		o.errorMapper = m
+	}
+}
+
+{{- if eq .Backend "grpc" }}
+// Handler returns a handler to the GraphQL API that calls
+// the gRPC service over conn. The headers of each GraphQL
+// request are forwarded to the service as gRPC metadata.
+func Handler(conn grpc.ClientConnInterface, opts ...Option) http.Handler {
+	o := &options{maxBatch: 100, wait: time.Millisecond}
+	for _, opt := range opts {
+		opt(o)
+	}
+	service := {{lookupImport .ModPath}}.New{{.ServiceName}}Client(conn)
+	es := {{exec "NewExecutableSchema"}}({{exec "Config"}}{Resolvers: &Resolver{ {{- .ServiceField}}: service}})
+	srv := handler.New(es)
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.SetErrorPresenter(errorPresenter(o))
+	{{- if .Loaders }}
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(WithLoaders(ctx, NewLoaders(service, o.maxBatch, o.wait)))
+	})
+	{{- end }}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srv.ServeHTTP(w, r.WithContext(outgoingMetadata(r)))
+	})
+}
+
+// skippedHeaders describe the HTTP request itself, rather
+// than metadata that the gRPC service should receive.
+var skippedHeaders = map[string]bool{
+	"accept-encoding":   true,
+	"connection":        true,
+	"content-length":    true,
+	"content-type":      true,
+	"host":              true,
+	"keep-alive":        true,
+	"proxy-connection":  true,
+	"te":                true,
+	"trailer":           true,
+	"transfer-encoding": true,
+	"upgrade":           true,
+	"user-agent":        true,
+}
+
+// outgoingMetadata returns the context of r with its
+// headers appended to the outgoing gRPC metadata.
+func outgoingMetadata(r *http.Request) context.Context {
+	md := metadata.MD{}
+	for k, v := range r.Header {
+		k = strings.ToLower(k)
+		if skippedHeaders[k] || strings.HasPrefix(k, "grpc-") {
+			continue
+		}
+		md.Append(k, v...)
+	}
+	if prev, ok := metadata.FromOutgoingContext(r.Context()); ok {
+		md = metadata.Join(prev, md)
+	}
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+{{- else if eq .Backend "connect" }}
+// Handler returns a handler to the GraphQL API that calls
+// the service through a connect client. The headers of each
+// GraphQL request are forwarded to the service.
+func Handler(client {{lookupImport .ModPath}}.{{.ServiceName}}Client, opts ...Option) http.Handler {
+	o := &options{maxBatch: 100, wait: time.Millisecond}
+	for _, opt := range opts {
+		opt(o)
+	}
+	service := newConnectService(client)
+	es := {{exec "NewExecutableSchema"}}({{exec "Config"}}{Resolvers: &Resolver{ {{- .ServiceField}}: service}})
+	srv := handler.New(es)
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.SetErrorPresenter(errorPresenter(o))
+	{{- if .Loaders }}
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(WithLoaders(ctx, NewLoaders(service, o.maxBatch, o.wait)))
+	})
+	{{- end }}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		srv.ServeHTTP(w, r.WithContext(withHeaders(r.Context(), r.Header)))
+	})
+}
+{{- else }}
 // Handler returns a handler to the GraphQL API.
 // Server Hooks are optional but if present, they will
 // be injected as GraphQL middleware.
-func Handler(service {{lookupImport .ModPath}}.{{.ServiceName}}, hooks *twirp.ServerHooks) *handler.Server {
-	es := NewExecutableSchema(Config{Resolvers: &Resolver{service}})
+func Handler(service {{lookupImport .ModPath}}.{{.ServiceName}}, hooks *twirp.ServerHooks, opts ...Option) *handler.Server {
+	o := &options{maxBatch: 100, wait: time.Millisecond}
+	for _, opt := range opts {
+		opt(o)
+	}
+	es := {{exec "NewExecutableSchema"}}({{exec "Config"}}{Resolvers: &Resolver{ {{- .ServiceField}}: service}})
 	srv := handler.New(es)
 	srv.AddTransport(transport.POST{})
 	srv.Use(extension.Introspection{})
+	srv.SetErrorPresenter(errorPresenter(o))
+	{{- if .Loaders }}
+	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		return next(WithLoaders(ctx, NewLoaders(service, o.maxBatch, o.wait)))
+	})
+	{{- end }}
 	if hooks == nil {
 		return srv
 	}
@@ -52,4 +195,134 @@ func Handler(service {{lookupImport .ModPath}}.{{.ServiceName}}, hooks *twirp.Se
 	})
 	return srv
 }
+
+{{- end }}
+
+// retryableCodes are the codes of errors that
+// clients may retry, preferably with a backoff.
+var retryableCodes = map[string]bool{
+	"aborted":            true,
+	"deadline_exceeded":  true,
+	"resource_exhausted": true,
+	"unavailable":        true,
+}
+
+// internalCodes are the codes of errors
+// that WithProduction hides from clients.
+var internalCodes = map[string]bool{
+	"data_loss": true,
+	"internal":  true,
+	"unknown":   true,
+}
+
+// errorPresenter returns the GraphQL errors of the resolvers with
+// the code, meta and retryable extensions of the service errors.
+// Errors that carry their own extensions, such as those of
+// hand-written resolvers, keep them, while the other errors
+// that are not service errors are internal errors.
+func errorPresenter(o *options) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		if o.errorMapper != nil {
+			if gqlErr := o.errorMapper(ctx, err); gqlErr != nil {
+				if gqlErr.Path == nil {
+					gqlErr.Path = graphql.GetFieldContext(ctx).Path()
+				}
+				return gqlErr
+			}
+		}
+		if _, ok := err.(*gqlerror.Error); ok {
+			return graphql.DefaultErrorPresenter(ctx, err)
+		}
+		var extendedErr graphql.ExtendedError
+		if errors.As(err, &extendedErr) {
+			return &gqlerror.Error{
+				Message:    err.Error(),
+				Path:       graphql.GetFieldContext(ctx).Path(),
+				Extensions: extendedErr.Extensions(),
+			}
+		}
+		code, msg, meta, ok := serviceError(err)
+		if !ok {
+			code, msg = "internal", err.Error()
+		}
+		if o.production && internalCodes[code] {
+			msg, meta = "internal error", nil
+		}
+		gqlErr := &gqlerror.Error{
+			Message: msg,
+			Path:    graphql.GetFieldContext(ctx).Path(),
+			Extensions: map[string]interface{}{
+				"code":      code,
+				"retryable": retryableCodes[code],
+			},
+		}
+		if len(meta) > 0 {
+			gqlErr.Extensions["meta"] = meta
+		}
+		return gqlErr
+	}
+}
+{{- if eq .Backend "grpc" }}
+
+// grpcCodes are the names of the gRPC codes, which
+// are the same as the names of the Twirp codes.
+var grpcCodes = map[codes.Code]string{
+	codes.Canceled:           "canceled",
+	codes.Unknown:            "unknown",
+	codes.InvalidArgument:    "invalid_argument",
+	codes.DeadlineExceeded:   "deadline_exceeded",
+	codes.NotFound:           "not_found",
+	codes.AlreadyExists:      "already_exists",
+	codes.PermissionDenied:   "permission_denied",
+	codes.ResourceExhausted:  "resource_exhausted",
+	codes.FailedPrecondition: "failed_precondition",
+	codes.Aborted:            "aborted",
+	codes.OutOfRange:         "out_of_range",
+	codes.Unimplemented:      "unimplemented",
+	codes.Internal:           "internal",
+	codes.Unavailable:        "unavailable",
+	codes.DataLoss:           "data_loss",
+	codes.Unauthenticated:    "unauthenticated",
+}
+
+// serviceError returns the code, message and meta of a gRPC
+// status error. The meta is the metadata of its ErrorInfo.
+func serviceError(err error) (string, string, map[string]string, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return "", "", nil, false
+	}
+	code, ok := grpcCodes[st.Code()]
+	if !ok {
+		code = "unknown"
+	}
+	var meta map[string]string
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			meta = info.GetMetadata()
+		}
+	}
+	return code, st.Message(), meta, true
+}
+{{- else if eq .Backend "connect" }}
+
+// serviceError returns the code and message of a connect error.
+func serviceError(err error) (string, string, map[string]string, bool) {
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) {
+		return "", "", nil, false
+	}
+	return cerr.Code().String(), cerr.Message(), nil, true
+}
+{{- else }}
+
+// serviceError returns the code, message and meta of a Twirp error.
+func serviceError(err error) (string, string, map[string]string, bool) {
+	var terr twirp.Error
+	if !errors.As(err, &terr) {
+		return "", "", nil, false
+	}
+	return string(terr.Code()), terr.Msg(), terr.MetaMap(), true
+}
+{{- end }}
 `
@@ -9,10 +9,11 @@ import (
 
 var tmpl = template.Must(template.New("genunions").Parse(tmplStr))
 
-// Render renders a union
-func Render(w io.Writer) error {
+// Render renders the marker interface of unions, named
+// typeName, in package pkgName
+func Render(pkgName, typeName string, w io.Writer) error {
 	var bts bytes.Buffer
-	err := tmpl.Execute(&bts, nil)
+	err := tmpl.Execute(&bts, struct{ PackageName, Name string }{pkgName, typeName})
 	if err != nil {
 		return err
 	}
@@ -26,7 +27,7 @@ func Render(w io.Writer) error {
 
 const tmplStr = `// Code generated by github.com/tmc/protoc-gen-graphql, DO NOT EDIT.
 
-package gengraphql
+package {{.PackageName}}
 
-type unionMask interface {}
+type {{.Name}} interface {}
 `
@@ -114,6 +114,37 @@ func ParseLax(file string, data []byte, fix VersionFixer) (*File, error) {
 	return parseToFile(file, data, fix, false)
 }
 
+// ParseLaxReplace is like ParseLax but also keeps the replace
+// statements, which apply to the import paths of the packages
+// of the main module and of the modules of a workspace. It
+// skips the replace statements that it can not parse.
+func ParseLaxReplace(file string, data []byte, fix VersionFixer) (*File, error) {
+	f, err := ParseLax(file, data, fix)
+	if err != nil {
+		return nil, err
+	}
+	var errs bytes.Buffer
+	for _, x := range f.Syntax.Stmt {
+		switch x := x.(type) {
+		case *Line:
+			if x.Token[0] == "replace" {
+				if r := parseReplace(&errs, file, x, "replace", x.Token[1:], fix); r != nil {
+					f.Replace = append(f.Replace, r)
+				}
+			}
+		case *LineBlock:
+			if len(x.Token) == 1 && x.Token[0] == "replace" {
+				for _, l := range x.Line {
+					if r := parseReplace(&errs, file, l, "replace", l.Token, fix); r != nil {
+						f.Replace = append(f.Replace, r)
+					}
+				}
+			}
+		}
+	}
+	return f, nil
+}
+
 func parseToFile(file string, data []byte, fix VersionFixer, strict bool) (*File, error) {
 	fs, err := parse(file, data)
 	if err != nil {
@@ -142,7 +173,7 @@ func parseToFile(file string, data []byte, fix VersionFixer, strict bool) (*File
 					fmt.Fprintf(&errs, "%s:%d: unknown block type: %s\n", file, x.Start.Line, strings.Join(x.Token, " "))
 				}
 				continue
-			case "module", "require", "exclude", "replace":
+			case "module", "require", "exclude", "replace", "retract", "godebug":
 				for _, l := range x.Line {
 					f.add(&errs, l, x.Token[0], l.Token, fix, strict)
 				}
@@ -178,6 +209,9 @@ func (f *File) add(errs *bytes.Buffer, line *Line, verb string, args []string, f
 	default:
 		fmt.Fprintf(errs, "%s:%d: unknown directive: %s\n", f.Syntax.Name, line.Start.Line, verb)
 
+	case "toolchain", "retract", "godebug":
+		// These directives are newer than this copy
+		// and do not change the modules in use.
 	case "go":
 		if f.Go != nil {
 			fmt.Fprintf(errs, "%s:%d: repeated go statement\n", f.Syntax.Name, line.Start.Line)
@@ -247,73 +281,81 @@ func (f *File) add(errs *bytes.Buffer, line *Line, verb string, args []string, f
 			})
 		}
 	case "replace":
-		arrow := 2
-		if len(args) >= 2 && args[1] == "=>" {
-			arrow = 1
-		}
-		if len(args) < arrow+2 || len(args) > arrow+3 || args[arrow] != "=>" {
-			fmt.Fprintf(errs, "%s:%d: usage: %s module/path [v1.2.3] => other/module v1.4\n\t or %s module/path [v1.2.3] => ../local/directory\n", f.Syntax.Name, line.Start.Line, verb, verb)
-			return
-		}
-		s, err := parseString(&args[0])
-		if err != nil {
-			fmt.Fprintf(errs, "%s:%d: invalid quoted string: %v\n", f.Syntax.Name, line.Start.Line, err)
-			return
+		if r := parseReplace(errs, f.Syntax.Name, line, verb, args, fix); r != nil {
+			f.Replace = append(f.Replace, r)
 		}
-		pathMajor, err := modulePathMajor(s)
+	}
+}
+
+// parseReplace parses the arguments of a replace directive of a
+// go.mod or go.work file, reporting errors to errs.
+func parseReplace(errs *bytes.Buffer, filename string, line *Line, verb string, args []string, fix VersionFixer) *Replace {
+	arrow := 2
+	if len(args) >= 2 && args[1] == "=>" {
+		arrow = 1
+	}
+	if len(args) < arrow+2 || len(args) > arrow+3 || args[arrow] != "=>" {
+		fmt.Fprintf(errs, "%s:%d: usage: %s module/path [v1.2.3] => other/module v1.4\n\t or %s module/path [v1.2.3] => ../local/directory\n", filename, line.Start.Line, verb, verb)
+		return nil
+	}
+	s, err := parseString(&args[0])
+	if err != nil {
+		fmt.Fprintf(errs, "%s:%d: invalid quoted string: %v\n", filename, line.Start.Line, err)
+		return nil
+	}
+	pathMajor, err := modulePathMajor(s)
+	if err != nil {
+		fmt.Fprintf(errs, "%s:%d: %v\n", filename, line.Start.Line, err)
+		return nil
+	}
+	var v string
+	if arrow == 2 {
+		old := args[1]
+		v, err = parseVersion(s, &args[1], fix)
 		if err != nil {
-			fmt.Fprintf(errs, "%s:%d: %v\n", f.Syntax.Name, line.Start.Line, err)
-			return
+			fmt.Fprintf(errs, "%s:%d: invalid module version %v: %v\n", filename, line.Start.Line, old, err)
+			return nil
 		}
-		var v string
-		if arrow == 2 {
-			old := args[1]
-			v, err = parseVersion(s, &args[1], fix)
-			if err != nil {
-				fmt.Fprintf(errs, "%s:%d: invalid module version %v: %v\n", f.Syntax.Name, line.Start.Line, old, err)
-				return
-			}
-			if !module.MatchPathMajor(v, pathMajor) {
-				if pathMajor == "" {
-					pathMajor = "v0 or v1"
-				}
-				fmt.Fprintf(errs, "%s:%d: invalid module: %s should be %s, not %s (%s)\n", f.Syntax.Name, line.Start.Line, s, pathMajor, semver.Major(v), v)
-				return
+		if !module.MatchPathMajor(v, pathMajor) {
+			if pathMajor == "" {
+				pathMajor = "v0 or v1"
 			}
+			fmt.Fprintf(errs, "%s:%d: invalid module: %s should be %s, not %s (%s)\n", filename, line.Start.Line, s, pathMajor, semver.Major(v), v)
+			return nil
 		}
-		ns, err := parseString(&args[arrow+1])
-		if err != nil {
-			fmt.Fprintf(errs, "%s:%d: invalid quoted string: %v\n", f.Syntax.Name, line.Start.Line, err)
-			return
+	}
+	ns, err := parseString(&args[arrow+1])
+	if err != nil {
+		fmt.Fprintf(errs, "%s:%d: invalid quoted string: %v\n", filename, line.Start.Line, err)
+		return nil
+	}
+	nv := ""
+	if len(args) == arrow+2 {
+		if !IsDirectoryPath(ns) {
+			fmt.Fprintf(errs, "%s:%d: replacement module without version must be directory path (rooted or starting with ./ or ../)\n", filename, line.Start.Line)
+			return nil
 		}
-		nv := ""
-		if len(args) == arrow+2 {
-			if !IsDirectoryPath(ns) {
-				fmt.Fprintf(errs, "%s:%d: replacement module without version must be directory path (rooted or starting with ./ or ../)\n", f.Syntax.Name, line.Start.Line)
-				return
-			}
-			if filepath.Separator == '/' && strings.Contains(ns, `\`) {
-				fmt.Fprintf(errs, "%s:%d: replacement directory appears to be Windows path (on a non-windows system)\n", f.Syntax.Name, line.Start.Line)
-				return
-			}
+		if filepath.Separator == '/' && strings.Contains(ns, `\`) {
+			fmt.Fprintf(errs, "%s:%d: replacement directory appears to be Windows path (on a non-windows system)\n", filename, line.Start.Line)
+			return nil
 		}
-		if len(args) == arrow+3 {
-			old := args[arrow+1]
-			nv, err = parseVersion(ns, &args[arrow+2], fix)
-			if err != nil {
-				fmt.Fprintf(errs, "%s:%d: invalid module version %v: %v\n", f.Syntax.Name, line.Start.Line, old, err)
-				return
-			}
-			if IsDirectoryPath(ns) {
-				fmt.Fprintf(errs, "%s:%d: replacement module directory path %q cannot have version\n", f.Syntax.Name, line.Start.Line, ns)
-				return
-			}
+	}
+	if len(args) == arrow+3 {
+		old := args[arrow+1]
+		nv, err = parseVersion(ns, &args[arrow+2], fix)
+		if err != nil {
+			fmt.Fprintf(errs, "%s:%d: invalid module version %v: %v\n", filename, line.Start.Line, old, err)
+			return nil
 		}
-		f.Replace = append(f.Replace, &Replace{
-			Old:    module.Version{Path: s, Version: v},
-			New:    module.Version{Path: ns, Version: nv},
-			Syntax: line,
-		})
+		if IsDirectoryPath(ns) {
+			fmt.Fprintf(errs, "%s:%d: replacement module directory path %q cannot have version\n", filename, line.Start.Line, ns)
+			return nil
+		}
+	}
+	return &Replace{
+		Old:    module.Version{Path: s, Version: v},
+		New:    module.Version{Path: ns, Version: nv},
+		Syntax: line,
 	}
 }
 
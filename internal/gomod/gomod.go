@@ -0,0 +1,284 @@
+// Package gomod resolves the import paths of the Go packages in
+// directories from the go.mod and go.work files above them, as
+// the go command would, but without running it.
+package gomod
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/tmc/protoc-gen-graphql/internal/gocopy/modfile"
+)
+
+// Resolver resolves import paths, caching the go.mod and go.work
+// files that it reads and the import path of each directory.
+type Resolver struct {
+	// paths are the import paths of directories.
+	paths map[string]string
+
+	// modules are the go.mod files in
+	// directories, nil when there is none.
+	modules map[string]*modfile.File
+
+	// workspaces are the parsed go.work files.
+	workspaces map[string]*workspace
+
+	// mainReplaces are the replacements of the main module,
+	// the module of the working directory, which apply
+	// outside of workspaces.
+	mainReplaces []*replacement
+	mainLoaded   bool
+}
+
+// workspace holds the directories of the modules that a go.work
+// file uses, and the replacements of the go.work and of those
+// modules' go.mod files.
+type workspace struct {
+	roots    []string
+	replaces []*replacement
+}
+
+// replacement is a module replaced by a local directory, such
+// as "replace example.com/api => ./api", whose packages are
+// imported with the path of the replaced module.
+type replacement struct {
+	dir  string
+	path string
+}
+
+// NewResolver returns a Resolver with empty caches.
+func NewResolver() *Resolver {
+	return &Resolver{
+		paths:      map[string]string{},
+		modules:    map[string]*modfile.File{},
+		workspaces: map[string]*workspace{},
+	}
+}
+
+// ImportPath returns the import path of the package in dir. The
+// package belongs to the module of the go.work above dir whose
+// directory is the closest to dir, or else to the module of the
+// closest go.mod above dir. Unless $GOWORK names a go.work file
+// or turns workspaces off, the go.work is the closest above dir.
+// Packages in directories that replace a module, in the go.work
+// or in the go.mod of the working directory's module, have the
+// import path of the replaced module.
+func (r *Resolver) ImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	if p, ok := r.paths[dir]; ok {
+		return p, nil
+	}
+	root, replaces, err := r.moduleRoot(dir)
+	if err != nil {
+		return "", err
+	}
+	// The closest replacement wins, and of those
+	// for the same directory, the first one.
+	modPath := r.modules[root].Module.Mod.Path
+	replaced := false
+	for _, repl := range replaces {
+		if !within(dir, repl.dir) || len(repl.dir) < len(root) || replaced && len(repl.dir) == len(root) {
+			continue
+		}
+		root, modPath, replaced = repl.dir, repl.path, true
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return "", err
+	}
+	p := modPath
+	if rel != "." {
+		p = path.Join(p, filepath.ToSlash(rel))
+	}
+	r.paths[dir] = p
+	return p, nil
+}
+
+// moduleRoot returns the directory of the module that
+// dir belongs to, and the replacements that apply to it.
+func (r *Resolver) moduleRoot(dir string) (string, []*replacement, error) {
+	work, err := r.findWork(dir)
+	if err != nil {
+		return "", nil, err
+	}
+	var replaces []*replacement
+	if work != "" {
+		ws, err := r.workspace(work)
+		if err != nil {
+			return "", nil, err
+		}
+		root := ""
+		for _, use := range ws.roots {
+			if within(dir, use) && len(use) > len(root) {
+				root = use
+			}
+		}
+		if root != "" {
+			return root, ws.replaces, nil
+		}
+		replaces = ws.replaces
+	} else {
+		replaces, err = r.mainReplacements()
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	root, err := r.findModule(dir)
+	if err != nil {
+		return "", nil, err
+	}
+	return root, replaces, nil
+}
+
+// findModule returns the directory of the closest go.mod above dir.
+func (r *Resolver) findModule(dir string) (string, error) {
+	for d := dir; ; d = filepath.Dir(d) {
+		modf, err := r.module(d)
+		if err != nil {
+			return "", err
+		}
+		if modf != nil {
+			return d, nil
+		}
+		if filepath.Dir(d) == d {
+			return "", fmt.Errorf("%v is not in a Go module: there is no go.mod file in it or its parents", dir)
+		}
+	}
+}
+
+// findWork returns the go.work file of dir,
+// or an empty string if it has none.
+func (r *Resolver) findWork(dir string) (string, error) {
+	switch gowork := os.Getenv("GOWORK"); gowork {
+	case "off":
+		return "", nil
+	case "":
+	default:
+		return filepath.Abs(gowork)
+	}
+	for d := dir; ; d = filepath.Dir(d) {
+		filename := filepath.Join(d, "go.work")
+		if _, err := os.Stat(filename); err == nil {
+			return filename, nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+		if filepath.Dir(d) == d {
+			return "", nil
+		}
+	}
+}
+
+// workspace reads a go.work file and the go.mod
+// files of the modules that it uses.
+func (r *Resolver) workspace(filename string) (*workspace, error) {
+	if ws, ok := r.workspaces[filename]; ok {
+		return ws, nil
+	}
+	bts, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	work, err := modfile.ParseWork(filename, bts, nil)
+	if err != nil {
+		return nil, err
+	}
+	workDir := filepath.Dir(filename)
+	ws := &workspace{
+		replaces: replacements(workDir, work.Replace),
+	}
+	for _, use := range work.Use {
+		root := localDir(workDir, use.Path)
+		modf, err := r.module(root)
+		if err != nil {
+			return nil, err
+		}
+		if modf == nil {
+			return nil, fmt.Errorf("%v: directory %v does not contain a go.mod file", filename, use.Path)
+		}
+		ws.roots = append(ws.roots, root)
+		ws.replaces = append(ws.replaces, replacements(root, modf.Replace)...)
+	}
+	r.workspaces[filename] = ws
+	return ws, nil
+}
+
+// mainReplacements returns the replacements of the go.mod file
+// of the working directory's module, if it is in one.
+func (r *Resolver) mainReplacements() ([]*replacement, error) {
+	if r.mainLoaded {
+		return r.mainReplaces, nil
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	if root, err := r.findModule(wd); err == nil {
+		r.mainReplaces = replacements(root, r.modules[root].Replace)
+	}
+	r.mainLoaded = true
+	return r.mainReplaces, nil
+}
+
+// module returns the go.mod file in dir, or nil if there is none.
+func (r *Resolver) module(dir string) (*modfile.File, error) {
+	if modf, ok := r.modules[dir]; ok {
+		return modf, nil
+	}
+	filename := filepath.Join(dir, "go.mod")
+	bts, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		r.modules[dir] = nil
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	// Directives newer than the copy of modfile, such as tool
+	// or ignore, must not prevent finding the import paths.
+	modf, err := modfile.ParseLaxReplace(filename, bts, nil)
+	if err != nil {
+		return nil, err
+	}
+	if modf.Module == nil || modf.Module.Mod.Path == "" {
+		return nil, fmt.Errorf("%v: no module statement", filename)
+	}
+	r.modules[dir] = modf
+	return modf, nil
+}
+
+// replacements returns the replace directives that
+// replace a module with a directory, relative to dir.
+func replacements(dir string, replaces []*modfile.Replace) []*replacement {
+	res := []*replacement{}
+	for _, repl := range replaces {
+		if repl.New.Version == "" && modfile.IsDirectoryPath(repl.New.Path) {
+			res = append(res, &replacement{
+				dir:  localDir(dir, repl.New.Path),
+				path: repl.Old.Path,
+			})
+		}
+	}
+	return res
+}
+
+// localDir returns the directory of a path in a
+// go.mod or go.work file that is in dir.
+func localDir(dir, p string) string {
+	p = filepath.FromSlash(p)
+	if filepath.IsAbs(p) {
+		return filepath.Clean(p)
+	}
+	return filepath.Join(dir, p)
+}
+
+// within reports whether dir is root or inside of it.
+func within(dir, root string) bool {
+	return dir == root || strings.HasPrefix(dir, root+string(filepath.Separator))
+}
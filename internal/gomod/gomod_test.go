@@ -0,0 +1,125 @@
+package gomod
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportPath(t *testing.T) {
+	t.Setenv("GOWORK", "")
+	dir := writeTree(t, map[string]string{
+		"go.mod":               "module example.com/root\n\ngo 1.21\n\ntoolchain go1.22.0\n\nretract (\n\tv1.0.0\n)\n",
+		"api/v1/service.proto": "",
+		"nested/go.mod":        "module example.com/nested\n\ngo 1.25\n\ntool example.com/cmd/gen\n\ntool (\n\texample.com/cmd/lint\n)\n\nignore ./node_modules\n",
+		"nested/pkg/x.proto":   "",
+	})
+	r := NewResolver()
+	for rel, want := range map[string]string{
+		".":          "example.com/root",
+		"api/v1":     "example.com/root/api/v1",
+		"nested":     "example.com/nested",
+		"nested/pkg": "example.com/nested/pkg",
+	} {
+		got, err := r.ImportPath(filepath.Join(dir, rel))
+		require.NoError(t, err)
+		require.Equal(t, want, got, rel)
+	}
+}
+
+func TestImportPathWorkspace(t *testing.T) {
+	t.Setenv("GOWORK", "")
+	dir := writeTree(t, map[string]string{
+		"go.work":           "go 1.21\n\nuse (\n\t./a\n\t./b\n\t./b/c\n)\n",
+		"a/go.mod":          "module example.com/a\n",
+		"a/proto/a.proto":   "",
+		"b/go.mod":          "module example.com/b\n",
+		"b/c/go.mod":        "module example.com/c\n",
+		"b/c/proto/c.proto": "",
+		"unused/go.mod":     "module example.com/unused\n",
+		"unused/u.proto":    "",
+	})
+	r := NewResolver()
+	for rel, want := range map[string]string{
+		"a/proto":   "example.com/a/proto",
+		"b":         "example.com/b",
+		"b/c/proto": "example.com/c/proto",
+		// Outside of the workspace's modules,
+		// the closest go.mod is used.
+		"unused": "example.com/unused",
+	} {
+		got, err := r.ImportPath(filepath.Join(dir, rel))
+		require.NoError(t, err)
+		require.Equal(t, want, got, rel)
+	}
+
+	t.Setenv("GOWORK", "off")
+	got, err := NewResolver().ImportPath(filepath.Join(dir, "b/c/proto"))
+	require.NoError(t, err)
+	require.Equal(t, "example.com/c/proto", got)
+}
+
+func TestImportPathReplace(t *testing.T) {
+	t.Setenv("GOWORK", "")
+	dir := writeTree(t, map[string]string{
+		"go.mod":                     "module example.com/root\n\ngo 1.24\n\ntool example.com/cmd/gen\n\nreplace example.com/api => ./third_party/api\n\nreplace example.com/other v1.0.0 => example.com/fork v1.0.1\n",
+		"third_party/api/go.mod":     "module github.com/fork/api\n",
+		"third_party/api/v1/a.proto": "",
+		"ws/go.work":                 "go 1.21\n\ngodebug (\n\tpanicnil=1\n)\n\nuse ./app\n\nreplace example.com/lib => ./lib\n",
+		"ws/app/go.mod":              "module example.com/app\n\ntool example.com/cmd/gen\n\nreplace (\n\texample.com/shared => ../shared\n)\n",
+		"ws/lib/go.mod":              "module example.com/lib/v2\n",
+		"ws/lib/pb/lib.proto":        "",
+		"ws/shared/go.mod":           "module example.com/fork/shared\n",
+	})
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	r := NewResolver()
+	for rel, want := range map[string]string{
+		// The main module's replacements
+		// apply outside of workspaces.
+		"third_party/api/v1": "example.com/api/v1",
+		// Workspaces apply the replacements of the
+		// go.work and of the modules that it uses.
+		"ws/lib/pb": "example.com/lib/pb",
+		"ws/shared": "example.com/shared",
+		"ws/app":    "example.com/app",
+	} {
+		got, err := r.ImportPath(filepath.Join(dir, rel))
+		require.NoError(t, err)
+		require.Equal(t, want, got, rel)
+	}
+}
+
+func TestImportPathErrors(t *testing.T) {
+	t.Setenv("GOWORK", "")
+	dir := writeTree(t, map[string]string{
+		"nomodule/go.mod": "go 1.21\n",
+		"badwork/go.work": "go 1.21\n\nuse ./missing\n",
+	})
+	_, err := NewResolver().ImportPath(filepath.Join(dir, "nomodule"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no module statement")
+
+	_, err = NewResolver().ImportPath(filepath.Join(dir, "badwork"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "directory ./missing does not contain a go.mod file")
+}
+
+// writeTree writes files into a temporary
+// directory and returns the directory.
+func writeTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		filename := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filename), 0755))
+		require.NoError(t, ioutil.WriteFile(filename, []byte(content), 0666))
+	}
+	return dir
+}
@@ -35,9 +35,42 @@ func PrintSchema(s *ast.Schema) (string, error) {
 	return out.String(), nil
 }
 
+// PrintSubgraph formats a given schema as the SDL of an
+// Apollo Federation subgraph, which starts with the given
+// schema extension. Its root types are printed as type
+// extensions, and the fields, types and directives of
+// the federation spec are left out since the gateway
+// defines them.
+func PrintSubgraph(s *ast.Schema, link string) (string, error) {
+	var out strings.Builder
+	f := &formatter{schema: s, out: &out, subgraph: true}
+	f.println(link)
+	f.println()
+	f.printSchema()
+	return out.String(), nil
+}
+
+// federationTypes and federationDirectives are
+// declared by the federation spec.
+var (
+	federationTypes = map[string]bool{
+		"_Service": true,
+		"_Entity":  true,
+		"_Any":     true,
+		"FieldSet": true,
+	}
+	federationDirectives = map[string]bool{
+		"key":       true,
+		"shareable": true,
+		"external":  true,
+	}
+)
+
 type formatter struct {
 	schema     *ast.Schema
 	out        io.Writer
+	subgraph   bool
+	interfaces []string
 	types      []string
 	inputs     []string
 	scalars    []string
@@ -50,6 +83,7 @@ func (f *formatter) printSchema() {
 	f.sortDeclarations()
 	f.printQuery()
 	f.printMutation()
+	f.printInterfaces()
 	f.printTypes()
 	f.printInputs()
 	f.printEnums()
@@ -63,7 +97,12 @@ func (f *formatter) sortDeclarations() {
 		if k == "Query" || k == "Mutation" || def.BuiltIn {
 			continue
 		}
+		if f.subgraph && federationTypes[k] {
+			continue
+		}
 		switch def.Kind {
+		case ast.Interface:
+			f.interfaces = append(f.interfaces, k)
 		case ast.Object:
 			f.types = append(f.types, k)
 		case ast.InputObject:
@@ -76,6 +115,7 @@ func (f *formatter) sortDeclarations() {
 			f.unions = append(f.unions, k)
 		}
 	}
+	sort.Strings(f.interfaces)
 	sort.Strings(f.types)
 	sort.Strings(f.inputs)
 	sort.Strings(f.enums)
@@ -85,20 +125,21 @@ func (f *formatter) sortDeclarations() {
 		if d.Position.Src.BuiltIn {
 			continue
 		}
+		if f.subgraph && federationDirectives[d.Name] {
+			continue
+		}
 		f.directives = append(f.directives, d.Name)
 	}
 	sort.Strings(f.directives)
 }
 
 func (f *formatter) printQuery() {
+	if f.subgraph && len(f.rootFields(f.schema.Query)) == 0 {
+		return
+	}
 	f.printDoc(f.schema.Query.Description, 0)
-	f.print("type Query")
-	f.printDirectives(f.schema.Query.Directives)
-	f.print(" {\n")
-	for _, field := range f.schema.Query.Fields {
-		if strings.HasPrefix(field.Name, "__") {
-			continue
-		}
+	f.printRootType(f.schema.Query)
+	for _, field := range f.rootFields(f.schema.Query) {
 		f.printDoc(field.Description, 1)
 		f.printf("\t%v", field.Name)
 		f.printArgs(field.Arguments)
@@ -116,6 +157,10 @@ func (f *formatter) printArgs(aa ast.ArgumentDefinitionList) {
 		arg := a.Name
 		arg += ": "
 		arg += a.Type.String()
+		if a.DefaultValue != nil {
+			arg += " = "
+			arg += a.DefaultValue.String()
+		}
 		args = append(args, arg)
 	}
 
@@ -132,14 +177,12 @@ func (f *formatter) fmtDirectiveArgument(a *ast.Argument) string {
 }
 
 func (f *formatter) printMutation() {
-	if f.schema.Mutation == nil || len(f.schema.Mutation.Fields) == 0 {
+	if f.schema.Mutation == nil || len(f.rootFields(f.schema.Mutation)) == 0 {
 		return
 	}
-	f.print("\ntype Mutation {\n")
-	for _, field := range f.schema.Mutation.Fields {
-		if strings.HasPrefix(field.Name, "__") {
-			continue
-		}
+	f.print("\n")
+	f.printRootType(f.schema.Mutation)
+	for _, field := range f.rootFields(f.schema.Mutation) {
 		doc := strings.TrimSpace(field.Description)
 		if doc != "" {
 			f.printDoc(doc, 1)
@@ -151,27 +194,75 @@ func (f *formatter) printMutation() {
 	f.print("}\n")
 }
 
+// printRootType prints the opening of a root type, which
+// extends the root type of the gateway in a subgraph.
+func (f *formatter) printRootType(def *ast.Definition) {
+	if f.subgraph {
+		f.print("extend ")
+	}
+	f.printf("type %v", def.Name)
+	f.printDirectives(def.Directives)
+	f.print(" {\n")
+}
+
+// rootFields returns the fields of a root type
+// without the introspection fields, nor the
+// federation fields in a subgraph.
+func (f *formatter) rootFields(def *ast.Definition) ast.FieldList {
+	fields := ast.FieldList{}
+	for _, field := range def.Fields {
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+		if f.subgraph && strings.HasPrefix(field.Name, "_") {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func (f *formatter) printInterfaces() {
+	for _, t := range f.interfaces {
+		f.print("\n")
+		typeDecl := f.schema.Types[t]
+		f.printDoc(typeDecl.Description, 0)
+		f.printf("interface %v", typeDecl.Name)
+		f.printDirectives(typeDecl.Directives)
+		f.print(" {\n")
+		f.printFields(typeDecl.Fields)
+		f.print("}\n")
+	}
+}
+
 func (f *formatter) printTypes() {
 	for _, t := range f.types {
 		f.print("\n")
 		typeDecl := f.schema.Types[t]
 		f.printDoc(typeDecl.Description, 0)
 		f.printf("type %v", typeDecl.Name)
+		if len(typeDecl.Interfaces) > 0 {
+			f.printf(" implements %v", strings.Join(typeDecl.Interfaces, " & "))
+		}
 		f.printDirectives(typeDecl.Directives)
 		f.print(" {\n")
-		for _, field := range typeDecl.Fields {
-			f.printDoc(field.Description, 1)
-			f.printf("\t%v: %v\n\n", field.Name, field.Type.String())
-		}
+		f.printFields(typeDecl.Fields)
 		f.print("}\n")
 	}
 }
 
-func (f *formatter) printDirectives(dirs []*ast.Directive) {
-	if len(dirs) > 0 {
-		f.print(" ")
+func (f *formatter) printFields(fields ast.FieldList) {
+	for _, field := range fields {
+		f.printDoc(field.Description, 1)
+		f.printf("\t%v: %v", field.Name, field.Type.String())
+		f.printDirectives(field.Directives)
+		f.print("\n\n")
 	}
+}
+
+func (f *formatter) printDirectives(dirs []*ast.Directive) {
 	for _, dir := range dirs {
+		f.print(" ")
 		f.printDirective(dir)
 	}
 }
@@ -197,7 +288,11 @@ func (f *formatter) printInputs() {
 		f.printf("input %v {\n", typeDecl.Name)
 		for _, field := range typeDecl.Fields {
 			f.printDoc(field.Description, 1)
-			f.printf("\t%v: %v\n", field.Name, field.Type.String())
+			f.printf("\t%v: %v", field.Name, field.Type.String())
+			if field.DefaultValue != nil {
+				f.printf(" = %v", field.DefaultValue.String())
+			}
+			f.println()
 		}
 		f.println("}")
 	}
@@ -1,33 +1,254 @@
 package main
 
 import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 
 	pgs "github.com/lyft/protoc-gen-star"
 	"github.com/tmc/protoc-gen-graphql/gengraphql"
-	"github.com/tmc/protoc-gen-graphql/internal/gocopy/modfile"
+	"github.com/tmc/protoc-gen-graphql/internal/breaking"
+	"github.com/tmc/protoc-gen-graphql/internal/gomod"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
+// commands are the subcommands that run when the
+// plugin is called by hand rather than by protoc.
+var commands = map[string]func(args []string) error{
+	"breaking": breakingCommand,
+	"generate": generateCommand,
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		cmd, ok := commands[os.Args[1]]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+			os.Exit(2)
+		}
+		if err := cmd(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	must(writeResponse(render(os.Stdin), os.Stdout))
+}
+
+// render runs the module on the CodeGeneratorRequest
+// read from in and returns the encoded response.
+func render(in io.Reader) []byte {
 	modname := getImportPath()
 	log.SetOutput(ioutil.Discard)
-	pgs.Init(pgs.DebugEnv("DEBUG")).
+	var resp bytes.Buffer
+	pgs.Init(pgs.DebugEnv("DEBUG"), pgs.ProtocInput(in), pgs.ProtocOutput(&resp)).
 		RegisterModule(gengraphql.New(modname)).
 		Render()
+	return resp.Bytes()
+}
+
+// writeResponse advertises the features this plugin supports
+// on the response rendered by protoc-gen-star, which predates
+// editions, before handing it back to protoc.
+func writeResponse(bts []byte, out io.Writer) error {
+	var resp pluginpb.CodeGeneratorResponse
+	if err := proto.Unmarshal(bts, &resp); err != nil {
+		return err
+	}
+	resp.SupportedFeatures = proto.Uint64(uint64(pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS))
+	resp.MinimumEdition = proto.Int32(int32(descriptorpb.Edition_EDITION_PROTO2))
+	resp.MaximumEdition = proto.Int32(int32(descriptorpb.Edition_EDITION_2023))
+	bts, err := proto.Marshal(&resp)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(bts)
+	return err
+}
+
+// breakingCommand prints the changes of a schema that
+// break the clients of an older one, and fails if any:
+//
+//	protoc-gen-graphql breaking old/schema.graphql schema.graphql
+func breakingCommand(args []string) error {
+	if len(args) != 2 {
+		return errors.New("usage: protoc-gen-graphql breaking <old schema> <new schema>")
+	}
+	old, err := breaking.Load(args[0])
+	if err != nil {
+		return err
+	}
+	updated, err := breaking.Load(args[1])
+	if err != nil {
+		return err
+	}
+	changes := breaking.Compare(old, updated)
+	for _, change := range changes {
+		fmt.Println(change)
+	}
+	if len(changes) > 0 {
+		return fmt.Errorf("%v breaking changes", len(changes))
+	}
+	return nil
 }
 
+// generateCommand runs the plugin without protoc, on a descriptor set
+// built by "buf build" or "protoc --include_imports -o", or on a
+// CodeGeneratorRequest captured with protoc-gen-debug:
+//
+//	protoc-gen-graphql generate --descriptor_set=api.binpb --file=svc.proto --service=X --out=dir
+//	protoc-gen-graphql generate --request=code_generator_request.pb.bin --out=dir
+//
+// The files are generated as if protoc had been run from the out
+// directory, which is where the .proto paths and output_path are
+// relative to.
+func generateCommand(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	descriptorSet := flags.String("descriptor_set", "", "FileDescriptorSet to generate from")
+	request := flags.String("request", "", "CodeGeneratorRequest to replay, instead of a descriptor set")
+	file := flags.String("file", "", "path of the .proto file to generate, as named in the descriptor set")
+	service := flags.String("service", "", "service to generate, if the file has several")
+	params := flags.String("param", "", "comma separated plugin parameters, such as gqlgen=false,client=true")
+	out := flags.String("out", ".", "directory to generate into")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", flags.Args())
+	}
+	var req *pluginpb.CodeGeneratorRequest
+	var err error
+	switch {
+	case *request != "" && *descriptorSet != "":
+		return errors.New("only one of --descriptor_set and --request can be given")
+	case *request != "":
+		req, err = readRequest(*request)
+	case *descriptorSet != "":
+		req, err = requestFromDescriptorSet(*descriptorSet)
+	default:
+		return errors.New("one of --descriptor_set or --request is required")
+	}
+	if err != nil {
+		return err
+	}
+	if *file != "" {
+		req.FileToGenerate = []string{*file}
+	}
+	if len(req.FileToGenerate) == 0 {
+		return errors.New("--file is required")
+	}
+	for _, name := range req.FileToGenerate {
+		if !hasFile(req.ProtoFile, name) {
+			return fmt.Errorf("%v is not in the descriptor set", name)
+		}
+	}
+	parameters := []string{}
+	for _, p := range []string{req.GetParameter(), *params} {
+		if p != "" {
+			parameters = append(parameters, p)
+		}
+	}
+	if *service != "" {
+		parameters = append(parameters, "service="+*service)
+	}
+	req.Parameter = proto.String(strings.Join(parameters, ","))
+	bts, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(*out, 0755); err != nil {
+		return err
+	}
+	if err := os.Chdir(*out); err != nil {
+		return err
+	}
+	return writeFiles(render(bytes.NewReader(bts)))
+}
+
+func readRequest(filename string) (*pluginpb.CodeGeneratorRequest, error) {
+	bts, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(bts, req); err != nil {
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+	return req, nil
+}
+
+// requestFromDescriptorSet builds the CodeGeneratorRequest that protoc
+// would send for the files of a descriptor set, which must include
+// the imports of its files, in the order that they depend on each other.
+func requestFromDescriptorSet(filename string) (*pluginpb.CodeGeneratorRequest, error) {
+	bts, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(bts, set); err != nil {
+		return nil, fmt.Errorf("%v: %v", filename, err)
+	}
+	for i, f := range set.File {
+		for _, dep := range f.Dependency {
+			if !hasFile(set.File[:i], dep) {
+				return nil, fmt.Errorf("%v: %v imports %v, which does not precede it; build the set with --include_imports", filename, f.GetName(), dep)
+			}
+		}
+	}
+	return &pluginpb.CodeGeneratorRequest{ProtoFile: set.File}, nil
+}
+
+func hasFile(files []*descriptorpb.FileDescriptorProto, name string) bool {
+	for _, f := range files {
+		if f.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// writeFiles writes the files of a CodeGeneratorResponse,
+// which protoc would otherwise write, and reports its error.
+func writeFiles(bts []byte) error {
+	var resp pluginpb.CodeGeneratorResponse
+	if err := proto.Unmarshal(bts, &resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return errors.New(resp.GetError())
+	}
+	for _, f := range resp.File {
+		if f.GetInsertionPoint() != "" {
+			return fmt.Errorf("%v: insertion points are not supported", f.GetName())
+		}
+		if err := os.MkdirAll(filepath.Dir(f.GetName()), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(f.GetName(), []byte(f.GetContent()), 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getImportPath returns the import path of the working
+// directory, or an empty string if it is not in a Go module.
 func getImportPath() string {
-	bts, err := ioutil.ReadFile("go.mod")
-	if os.IsNotExist(err) {
+	importPath, err := gomod.NewResolver().ImportPath(".")
+	if err != nil {
 		return ""
-	} else {
-		must(err)
 	}
-	modf, err := modfile.Parse("go.mod", bts, nil)
-	must(err)
-	return modf.Module.Mod.Path
+	return importPath
 }
 
 func must(err error) {
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestGenerate(t *testing.T) {
+	request, err := filepath.Abs("gengraphql/testdata/proto2import/code_generator_request.pb.bin")
+	require.NoError(t, err)
+	golden, err := ioutil.ReadFile("gengraphql/testdata/proto2import/schema.graphql.golden")
+	require.NoError(t, err)
+	req, err := readRequest(request)
+	require.NoError(t, err)
+	bts, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: req.ProtoFile})
+	require.NoError(t, err)
+	descriptorSet := filepath.Join(t.TempDir(), "api.binpb")
+	require.NoError(t, ioutil.WriteFile(descriptorSet, bts, 0666))
+
+	for name, args := range map[string][]string{
+		"request":        {"--request=" + request},
+		"descriptor_set": {"--descriptor_set=" + descriptorSet, "--file=proto2import.proto"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out := testModule(t)
+			args = append(args, "--param=gqlgen=false", "--out="+out)
+			require.NoError(t, generateCommand(args))
+			schema, err := ioutil.ReadFile(filepath.Join(out, "gengraphql", "schema.graphql"))
+			require.NoError(t, err)
+			require.Equal(t, string(golden), string(schema))
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	request, err := filepath.Abs("gengraphql/testdata/proto2import/code_generator_request.pb.bin")
+	require.NoError(t, err)
+	req, err := readRequest(request)
+	require.NoError(t, err)
+	// Without its import, the set can not be built into a graph.
+	bts, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: req.ProtoFile[1:]})
+	require.NoError(t, err)
+	descriptorSet := filepath.Join(t.TempDir(), "api.binpb")
+	require.NoError(t, ioutil.WriteFile(descriptorSet, bts, 0666))
+
+	for args, msg := range map[string]string{
+		"": "one of --descriptor_set or --request is required",
+		"--request=" + request + " --file=x.proto": "x.proto is not in the descriptor set",
+		"--descriptor_set=" + descriptorSet:        "proto2import.proto imports legacy.proto",
+	} {
+		err := generateCommand(strings.Fields(args))
+		require.Error(t, err, args)
+		require.Contains(t, err.Error(), msg)
+	}
+}
+
+func TestBreakingCommand(t *testing.T) {
+	golden := "gengraphql/testdata/simple/schema.graphql.golden"
+	old := "gengraphql/testdata/simple/breaking/schema.graphql"
+	require.NoError(t, breakingCommand([]string{golden, golden}))
+	require.NoError(t, breakingCommand([]string{golden, old}), "Expected added fields not to break clients")
+	err := breakingCommand([]string{old, golden})
+	require.EqualError(t, err, "2 breaking changes")
+	err = breakingCommand([]string{old})
+	require.EqualError(t, err, "usage: protoc-gen-graphql breaking <old schema> <new schema>")
+	err = breakingCommand([]string{filepath.Join(t.TempDir(), "schema.graphql"), golden})
+	require.True(t, os.IsNotExist(err), "Expected a missing schema to fail")
+}
+
+// testModule returns a directory with a Go module to
+// generate into, and restores the working directory
+// that generateCommand changes when the test ends.
+func testModule(t *testing.T) string {
+	t.Helper()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Chdir(wd) })
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/proto2import\n\ngo 1.16\n"), 0666))
+	return dir
+}